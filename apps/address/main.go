@@ -8,11 +8,15 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
+	"go-ecommerce/apps/address/region"
 	"go-ecommerce/pkg/config"
 	"go-ecommerce/pkg/database"
 	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/utils"
 	"go-ecommerce/proto/address"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -21,8 +25,12 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// 每个用户默认最多保存的地址数
+const defaultMaxPerUser = 20
+
 // 定义数据库模型 (跟数据库表结构对应)
 type Address struct {
 	ID            int64  `gorm:"primaryKey"`
@@ -32,32 +40,148 @@ type Address struct {
 	Province      string `gorm:"type:varchar(50)"`
 	City          string `gorm:"type:varchar(50)"`
 	District      string `gorm:"type:varchar(50)"`
+	ProvinceCode  string `gorm:"type:varchar(12)"`
+	CityCode      string `gorm:"type:varchar(12)"`
+	DistrictCode  string `gorm:"type:varchar(12)"`
 	DetailAddress string `gorm:"type:varchar(255)"`
 	IsDefault     bool   `gorm:"default:false"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"` // 软删除：历史订单仍可按 address_id 回溯
 }
 
 type server struct {
 	address.UnimplementedAddressServiceServer
-	db *gorm.DB
+	db         *gorm.DB
+	regions    *region.Dict
+	maxPerUser int
+}
+
+// AddressUserLock 每个用户一行，地址写操作在事务中锁定该行
+type AddressUserLock struct {
+	UserID int64 `gorm:"primaryKey;autoIncrement:false"`
+}
+
+func (AddressUserLock) TableName() string {
+	return "address_user_locks"
+}
+
+// lockUser 锁定用户的地址锁行，串行化同一用户的地址写操作
+// 保证并发新增/删除时默认地址有且只有一个；锁行在事务外创建，避免并发首次创建时互相死锁
+func (s *server) lockUser(tx *gorm.DB, userID int64) error {
+	if userID <= 0 {
+		return status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&AddressUserLock{UserID: userID}).Error; err != nil {
+		return err
+	}
+	var l AddressUserLock
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).Take(&l).Error
+}
+
+// txError 事务中返回的 gRPC 错误原样透传，其余按数据库错误处理
+func txError(err error, msg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	log.Printf("[Address] %s: %v", msg, err)
+	return status.Error(codes.Internal, msg)
+}
+
+// addressFields 新增/修改地址时需要校验的字段
+type addressFields struct {
+	Name, Mobile, DetailAddress string
+	region.Input
+}
+
+// validate 校验收货人、手机号与省市区，返回规范化后的结果
+func (s *server) validate(f addressFields) (*addressFields, *region.Resolved, error) {
+	f.Name = strings.TrimSpace(f.Name)
+	f.DetailAddress = strings.TrimSpace(f.DetailAddress)
+	if f.Name == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "收货人姓名不能为空")
+	}
+	if f.DetailAddress == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "详细地址不能为空")
+	}
+	mobile, ok := utils.NormalizeMobile(f.Mobile)
+	if !ok {
+		return nil, nil, status.Error(codes.InvalidArgument, "手机号格式不正确")
+	}
+	f.Mobile = mobile
+
+	r, err := s.regions.Resolve(f.Input)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &f, r, nil
+}
+
+// toAddressInfo 数据库模型转 proto
+func toAddressInfo(a Address) *address.AddressInfo {
+	return &address.AddressInfo{
+		Id:            a.ID,
+		UserId:        a.UserID,
+		Name:          a.Name,
+		Mobile:        a.Mobile,
+		Province:      a.Province,
+		City:          a.City,
+		District:      a.District,
+		DetailAddress: a.DetailAddress,
+		IsDefault:     a.IsDefault,
+		ProvinceCode:  a.ProvinceCode,
+		CityCode:      a.CityCode,
+		DistrictCode:  a.DistrictCode,
+		Deleted:       a.DeletedAt.Valid,
+	}
 }
 
 // 1. 新增地址
 func (s *server) CreateAddress(ctx context.Context, req *address.CreateAddressRequest) (*address.CreateAddressResponse, error) {
-	var count int64
-	s.db.Model(&Address{}).Where("user_id = ?", req.UserId).Count(&count)
+	f, r, err := s.validate(addressFields{
+		Name: req.Name, Mobile: req.Mobile, DetailAddress: req.DetailAddress,
+		Input: region.Input{
+			ProvinceCode: req.ProvinceCode, Province: req.Province,
+			CityCode: req.CityCode, City: req.City,
+			DistrictCode: req.DistrictCode, District: req.District,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	addr := Address{
 		UserID:        req.UserId,
-		Name:          req.Name,
-		Mobile:        req.Mobile,
-		Province:      req.Province,
-		City:          req.City,
-		District:      req.District,
-		DetailAddress: req.DetailAddress,
-		IsDefault:     count == 0, // 如果是首个地址，默认设为 true
-	}
-	if err := s.db.Create(&addr).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Database error")
+		Name:          f.Name,
+		Mobile:        f.Mobile,
+		Province:      r.Province,
+		City:          r.City,
+		District:      r.District,
+		ProvinceCode:  r.ProvinceCode,
+		CityCode:      r.CityCode,
+		DistrictCode:  r.DistrictCode,
+		DetailAddress: f.DetailAddress,
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.lockUser(tx, req.UserId); err != nil {
+			return err
+		}
+		var count, defaults int64
+		if err := tx.Model(&Address{}).Where("user_id = ?", req.UserId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(s.maxPerUser) {
+			return status.Errorf(codes.FailedPrecondition, "收货地址最多保存 %d 个", s.maxPerUser)
+		}
+		if err := tx.Model(&Address{}).Where("user_id = ? AND is_default = ?", req.UserId, true).Count(&defaults).Error; err != nil {
+			return err
+		}
+		addr.IsDefault = defaults == 0 // 还没有默认地址时 (如首个地址)，自动设为默认
+		return tx.Create(&addr).Error
+	})
+	if err != nil {
+		return nil, txError(err, "新增地址失败")
 	}
 	return &address.CreateAddressResponse{AddressId: addr.ID}, nil
 }
@@ -71,42 +195,42 @@ func (s *server) ListAddress(ctx context.Context, req *address.ListAddressReques
 
 	var respAddrs []*address.AddressInfo
 	for _, a := range addrs {
-		respAddrs = append(respAddrs, &address.AddressInfo{
-			Id:            a.ID,
-			Name:          a.Name,
-			Mobile:        a.Mobile,
-			Province:      a.Province,
-			City:          a.City,
-			District:      a.District,
-			DetailAddress: a.DetailAddress,
-			IsDefault:     a.IsDefault,
-		})
+		respAddrs = append(respAddrs, toAddressInfo(a))
 	}
 	return &address.ListAddressResponse{Addresses: respAddrs}, nil
 }
 
 // 3. 获取单个地址 (下单时用)
+// 必须校验归属，防止下单时使用他人的地址
 func (s *server) GetAddress(ctx context.Context, req *address.GetAddressRequest) (*address.GetAddressResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+	db := s.db
+	if req.IncludeDeleted {
+		db = db.Unscoped()
+	}
 	var a Address
-	if err := s.db.First(&a, req.AddressId).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Address not found")
-	}
-	return &address.GetAddressResponse{
-		Address: &address.AddressInfo{
-			Id:            a.ID,
-			Name:          a.Name,
-			Mobile:        a.Mobile,
-			Province:      a.Province,
-			City:          a.City,
-			District:      a.District,
-			DetailAddress: a.DetailAddress,
-			IsDefault:     a.IsDefault,
-		},
-	}, nil
+	if err := db.Where("id = ? AND user_id = ?", req.AddressId, req.UserId).First(&a).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "地址不存在或无权访问")
+	}
+	return &address.GetAddressResponse{Address: toAddressInfo(a)}, nil
 }
 
 // 4. 🔥 修复重点：修改地址
 func (s *server) UpdateAddress(ctx context.Context, req *address.UpdateAddressRequest) (*address.UpdateAddressResponse, error) {
+	f, r, err := s.validate(addressFields{
+		Name: req.Name, Mobile: req.Mobile, DetailAddress: req.DetailAddress,
+		Input: region.Input{
+			ProvinceCode: req.ProvinceCode, Province: req.Province,
+			CityCode: req.CityCode, City: req.City,
+			DistrictCode: req.DistrictCode, District: req.District,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var addr Address
 	// 先查询是否存在，且属于该用户 (安全检查)
 	if err := s.db.Where("id = ? AND user_id = ?", req.Id, req.UserId).First(&addr).Error; err != nil {
@@ -114,12 +238,15 @@ func (s *server) UpdateAddress(ctx context.Context, req *address.UpdateAddressRe
 	}
 
 	// 更新字段
-	addr.Name = req.Name
-	addr.Mobile = req.Mobile
-	addr.Province = req.Province
-	addr.City = req.City
-	addr.District = req.District
-	addr.DetailAddress = req.DetailAddress
+	addr.Name = f.Name
+	addr.Mobile = f.Mobile
+	addr.Province = r.Province
+	addr.City = r.City
+	addr.District = r.District
+	addr.ProvinceCode = r.ProvinceCode
+	addr.CityCode = r.CityCode
+	addr.DistrictCode = r.DistrictCode
+	addr.DetailAddress = f.DetailAddress
 
 	// 保存
 	if err := s.db.Save(&addr).Error; err != nil {
@@ -130,14 +257,39 @@ func (s *server) UpdateAddress(ctx context.Context, req *address.UpdateAddressRe
 }
 
 // 5. 🔥 修复重点：删除地址
+// 软删除，历史订单仍可回溯；删除的是默认地址时，顺延最近新增的地址为默认
 func (s *server) DeleteAddress(ctx context.Context, req *address.DeleteAddressRequest) (*address.DeleteAddressResponse, error) {
-	// 直接删除，带上 UserId 防止删错别人的
-	result := s.db.Where("id = ? AND user_id = ?", req.AddressId, req.UserId).Delete(&Address{})
-	if result.Error != nil {
-		return nil, status.Error(codes.Internal, "数据库错误")
-	}
-	if result.RowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "地址不存在或无权删除")
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.lockUser(tx, req.UserId); err != nil {
+			return err
+		}
+		// 带上 UserId 防止删错别人的
+		var addr Address
+		if err := tx.Where("id = ? AND user_id = ?", req.AddressId, req.UserId).First(&addr).Error; err != nil {
+			return status.Error(codes.NotFound, "地址不存在或无权删除")
+		}
+		if err := tx.Model(&addr).Update("is_default", false).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&addr).Error; err != nil {
+			return err
+		}
+		if !addr.IsDefault {
+			return nil
+		}
+
+		var next Address
+		err := tx.Where("user_id = ?", req.UserId).Order("id DESC").Take(&next).Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&next).Update("is_default", true).Error
+	})
+	if err != nil {
+		return nil, txError(err, "删除地址失败")
 	}
 	return &address.DeleteAddressResponse{Success: true}, nil
 }
@@ -146,6 +298,10 @@ func (s *server) DeleteAddress(ctx context.Context, req *address.DeleteAddressRe
 func (s *server) SetDefaultAddress(ctx context.Context, req *address.SetDefaultAddressRequest) (*address.SetDefaultAddressResponse, error) {
 	// 开启事务
 	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.lockUser(tx, req.UserId); err != nil {
+			return err
+		}
+
 		// 1. 先把该用户下所有的地址都设为非默认
 		if err := tx.Model(&Address{}).Where("user_id = ?", req.UserId).Update("is_default", false).Error; err != nil {
 			return err
@@ -157,19 +313,50 @@ func (s *server) SetDefaultAddress(ctx context.Context, req *address.SetDefaultA
 			return result.Error
 		}
 		if result.RowsAffected == 0 {
-			return fmt.Errorf("地址不存在")
+			return status.Error(codes.NotFound, "地址不存在")
 		}
 		return nil
 	})
 
 	if err != nil {
-		log.Printf("[Address] 设置默认地址失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "设置默认地址失败: %v", err)
+		return nil, txError(err, "设置默认地址失败")
 	}
 
 	return &address.SetDefaultAddressResponse{Success: true}, nil
 }
 
+// 7. 注销账号：清除用户全部地址
+// 订单中保存的是地址快照，物理删除 (含已软删除的地址) 不影响历史订单
+func (s *server) PurgeUserAddresses(ctx context.Context, req *address.PurgeUserAddressesRequest) (*address.PurgeUserAddressesResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+	result := s.db.Unscoped().Where("user_id = ?", req.UserId).Delete(&Address{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "数据库错误")
+	}
+	log.Printf("[Address] 用户 %d 注销，已清除 %d 条地址", req.UserId, result.RowsAffected)
+	return &address.PurgeUserAddressesResponse{Deleted: result.RowsAffected}, nil
+}
+
+// 8. 行政区划级联查询
+func (s *server) ListRegions(ctx context.Context, req *address.ListRegionsRequest) (*address.ListRegionsResponse, error) {
+	nodes, err := s.regions.Children(req.ParentCode)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "行政区划不存在")
+	}
+	resp := &address.ListRegionsResponse{Regions: make([]*address.RegionInfo, 0, len(nodes))}
+	for _, n := range nodes {
+		resp.Regions = append(resp.Regions, &address.RegionInfo{
+			Code:        n.Code,
+			Name:        n.Name,
+			Level:       int32(n.Level),
+			HasChildren: len(n.Children) > 0,
+		})
+	}
+	return resp, nil
+}
+
 func main() {
 	c, err := config.LoadConfig(".")
 	if err != nil {
@@ -194,7 +381,17 @@ func main() {
 	if err != nil {
 		log.Fatalf("Database init failed: %v", err)
 	}
-	db.AutoMigrate(&Address{})
+	db.AutoMigrate(&Address{}, &AddressUserLock{})
+
+	// 加载行政区划字典
+	regions, err := region.LoadFile(c.Address.RegionFile)
+	if err != nil {
+		log.Fatalf("加载行政区划数据失败: %v", err)
+	}
+	maxPerUser := c.Address.MaxPerUser
+	if maxPerUser <= 0 {
+		maxPerUser = defaultMaxPerUser
+	}
 
 	// 启动 gRPC
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", c.Service.Port))
@@ -203,7 +400,7 @@ func main() {
 	}
 
 	s := grpc.NewServer()
-	address.RegisterAddressServiceServer(s, &server{db: db})
+	address.RegisterAddressServiceServer(s, &server{db: db, regions: regions, maxPerUser: maxPerUser})
 	reflection.Register(s)
 
 	// 注册 Consul
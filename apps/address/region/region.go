@@ -0,0 +1,189 @@
+// Package region 行政区划字典 (省 / 市 / 区县)，编码遵循 GB/T 2260
+//
+// 默认使用内置数据集 regions.json (全部省、市与县级区划)，可通过配置指定外部文件替换。
+// 不设区县的城市 (如东莞、中山，以及仙桃等省直辖县级区划) 本身即为县级区划，区县编码取城市编码；
+// 港澳台只收录到省级，城市、区县按用户填写的名称保存。
+package region
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+//go:embed regions.json
+var bundled []byte
+
+// 行政区划层级
+const (
+	LevelProvince = 1
+	LevelCity     = 2
+	LevelDistrict = 3
+)
+
+// Region 行政区划节点
+type Region struct {
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	Children []*Region `json:"children,omitempty"`
+
+	Level  int     `json:"-"`
+	Parent *Region `json:"-"`
+}
+
+// Dict 行政区划字典，加载后只读，可并发使用
+type Dict struct {
+	roots  []*Region
+	byCode map[string]*Region
+}
+
+// ErrNotFound 区划编码不存在
+var ErrNotFound = errors.New("行政区划不存在")
+
+// Load 解析 JSON 数据集 (省份数组，逐级嵌套 children)
+func Load(data []byte) (*Dict, error) {
+	var roots []*Region
+	if err := json.Unmarshal(data, &roots); err != nil {
+		return nil, fmt.Errorf("解析行政区划数据失败: %w", err)
+	}
+	d := &Dict{roots: roots, byCode: make(map[string]*Region)}
+	if err := d.index(roots, nil, LevelProvince); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadFile 从外部文件加载，path 为空时使用内置数据集
+func LoadFile(path string) (*Dict, error) {
+	if path == "" {
+		return Load(bundled)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+func (d *Dict) index(nodes []*Region, parent *Region, level int) error {
+	if level > LevelDistrict && len(nodes) > 0 {
+		return fmt.Errorf("行政区划层级超过三级: %s", parent.Code)
+	}
+	for _, n := range nodes {
+		if n.Code == "" || n.Name == "" {
+			return errors.New("行政区划编码和名称不能为空")
+		}
+		if _, dup := d.byCode[n.Code]; dup {
+			return fmt.Errorf("行政区划编码重复: %s", n.Code)
+		}
+		n.Level = level
+		n.Parent = parent
+		d.byCode[n.Code] = n
+		if err := d.index(n.Children, n, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Children 返回下级区划，parentCode 为空时返回全部省份
+func (d *Dict) Children(parentCode string) ([]*Region, error) {
+	if parentCode == "" {
+		return d.roots, nil
+	}
+	n, ok := d.byCode[parentCode]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return n.Children, nil
+}
+
+// Input 待校验的省市区，编码与名称至少提供其一
+type Input struct {
+	ProvinceCode, Province string
+	CityCode, City         string
+	DistrictCode, District string
+}
+
+// Resolved 校验通过后的省市区
+// 字典收录的级别返回标准名称与编码；未收录的级别编码为空，名称保留用户输入
+type Resolved struct {
+	ProvinceCode, Province string
+	CityCode, City         string
+	DistrictCode, District string
+}
+
+// Resolve 校验省市区是否逐级匹配，并补全编码与标准名称
+func (d *Dict) Resolve(in Input) (*Resolved, error) {
+	var out Resolved
+
+	p, err := pick(d.roots, in.ProvinceCode, in.Province, "省份")
+	if err != nil {
+		return nil, err
+	}
+	out.ProvinceCode, out.Province = p.Code, p.Name
+
+	c, err := pickUnder(p, in.CityCode, in.City, "城市")
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		out.City, out.District = strings.TrimSpace(in.City), strings.TrimSpace(in.District)
+		return &out, nil
+	}
+	out.CityCode, out.City = c.Code, c.Name
+
+	if len(c.Children) == 0 {
+		// 城市不设区县，本身即为县级区划
+		code, name := strings.TrimSpace(in.DistrictCode), strings.TrimSpace(in.District)
+		if (code != "" && code != c.Code) || (name != "" && name != c.Name) {
+			return nil, fmt.Errorf("%s不设区县，区县请留空", c.Name)
+		}
+		out.DistrictCode, out.District = c.Code, c.Name
+		return &out, nil
+	}
+	dist, err := pick(c.Children, in.DistrictCode, in.District, "区县")
+	if err != nil {
+		return nil, err
+	}
+	out.DistrictCode, out.District = dist.Code, dist.Name
+	return &out, nil
+}
+
+// pickUnder 在 parent 的下级中查找；parent 没有收录下级时返回 nil (不校验)
+func pickUnder(parent *Region, code, name, label string) (*Region, error) {
+	if len(parent.Children) == 0 {
+		if code != "" {
+			return nil, fmt.Errorf("%s编码无效: %s", label, code)
+		}
+		return nil, nil
+	}
+	return pick(parent.Children, code, name, label)
+}
+
+// pick 按编码优先、名称其次在同级节点中查找，两者都提供时要求一致
+func pick(nodes []*Region, code, name, label string) (*Region, error) {
+	code, name = strings.TrimSpace(code), strings.TrimSpace(name)
+	if code == "" && name == "" {
+		return nil, fmt.Errorf("请选择%s", label)
+	}
+	for _, n := range nodes {
+		if code != "" && n.Code != code {
+			continue
+		}
+		if code == "" && n.Name != name {
+			continue
+		}
+		if name != "" && n.Name != name {
+			return nil, fmt.Errorf("%s名称与编码不一致: %s / %s", label, name, code)
+		}
+		return n, nil
+	}
+	if code != "" {
+		return nil, fmt.Errorf("%s编码无效或不属于上级区划: %s", label, code)
+	}
+	return nil, fmt.Errorf("%s不存在或不属于上级区划: %s", label, name)
+}
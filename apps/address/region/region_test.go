@@ -0,0 +1,194 @@
+package region
+
+import (
+	"strings"
+	"testing"
+)
+
+func bundledDict(t *testing.T) *Dict {
+	t.Helper()
+	d, err := LoadFile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestResolve(t *testing.T) {
+	d := bundledDict(t)
+	cases := []struct {
+		name    string
+		in      Input
+		want    Resolved
+		wantErr string // 为空表示应通过；否则为错误信息中应包含的内容
+	}{
+		{
+			name: "按编码补全名称",
+			in:   Input{ProvinceCode: "110000", CityCode: "110100", DistrictCode: "110105"},
+			want: Resolved{"110000", "北京市", "110100", "北京市", "110105", "朝阳区"},
+		},
+		{
+			name: "按名称补全编码",
+			in:   Input{Province: "广东省", City: "深圳市", District: "南山区"},
+			want: Resolved{"440000", "广东省", "440300", "深圳市", "440305", "南山区"},
+		},
+		{
+			name: "编码与名称一致",
+			in:   Input{ProvinceCode: "370000", Province: "山东省", CityCode: "370200", City: "青岛市", District: "黄岛区"},
+			want: Resolved{"370000", "山东省", "370200", "青岛市", "370211", "黄岛区"},
+		},
+		{
+			name:    "编码与名称不一致",
+			in:      Input{ProvinceCode: "370000", Province: "广东省", City: "青岛市", District: "黄岛区"},
+			wantErr: "省份名称与编码不一致",
+		},
+		{
+			name:    "城市不属于省份",
+			in:      Input{Province: "山东省", City: "深圳市", District: "南山区"},
+			wantErr: "城市不存在或不属于上级区划",
+		},
+		{
+			name:    "城市编码不属于省份",
+			in:      Input{Province: "山东省", CityCode: "440300", District: "南山区"},
+			wantErr: "城市编码无效或不属于上级区划",
+		},
+		{
+			name:    "未选择省份",
+			in:      Input{City: "深圳市"},
+			wantErr: "请选择省份",
+		},
+		{
+			name:    "城市收录了区县时必须选择区县",
+			in:      Input{Province: "广东省", City: "深圳市"},
+			wantErr: "请选择区县",
+		},
+		{
+			name:    "城市收录了区县时拒绝未知区县",
+			in:      Input{Province: "广东省", City: "深圳市", District: "朝阳区"},
+			wantErr: "区县不存在或不属于上级区划",
+		},
+		{
+			name:    "区县编码不属于城市",
+			in:      Input{Province: "广东省", City: "深圳市", DistrictCode: "110105"},
+			wantErr: "区县编码无效或不属于上级区划",
+		},
+		{
+			name: "补全地级市的区县",
+			in:   Input{Province: "山东省", City: "淄博市", District: " 张店区 "},
+			want: Resolved{"370000", "山东省", "370300", "淄博市", "370303", "张店区"},
+		},
+		{
+			name: "补全自治州的县级市",
+			in:   Input{ProvinceCode: "650000", CityCode: "652900", District: "库车市"},
+			want: Resolved{"650000", "新疆维吾尔自治区", "652900", "阿克苏地区", "652902", "库车市"},
+		},
+		{
+			name:    "拒绝字典中不存在的区县",
+			in:      Input{Province: "山东省", City: "淄博市", District: "张店"},
+			wantErr: "区县不存在或不属于上级区划",
+		},
+		{
+			name:    "拒绝不像区县的名称",
+			in:      Input{Province: "山东省", City: "淄博市", District: "阳光小区3号楼"},
+			wantErr: "区县不存在或不属于上级区划",
+		},
+		{
+			name: "不设区县的城市以城市作为区县",
+			in:   Input{Province: "广东省", City: "东莞市"},
+			want: Resolved{"440000", "广东省", "441900", "东莞市", "441900", "东莞市"},
+		},
+		{
+			name: "省直辖县级区划以自身作为区县",
+			in:   Input{ProvinceCode: "420000", CityCode: "429004", District: "仙桃市"},
+			want: Resolved{"420000", "湖北省", "429004", "仙桃市", "429004", "仙桃市"},
+		},
+		{
+			name:    "不设区县的城市拒绝填写镇",
+			in:      Input{Province: "广东省", City: "东莞市", District: "虎门镇"},
+			wantErr: "东莞市不设区县",
+		},
+		{
+			name:    "不设区县的城市拒绝其它区县编码",
+			in:      Input{Province: "广东省", City: "中山市", DistrictCode: "440305"},
+			wantErr: "中山市不设区县",
+		},
+		{
+			name: "省份未收录城市时保存填写的名称",
+			in:   Input{Province: "香港特别行政区", City: "九龙", District: "油尖旺区"},
+			want: Resolved{"810000", "香港特别行政区", "", "九龙", "", "油尖旺区"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := d.Resolve(c.in)
+			if c.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+					t.Fatalf("期望错误包含 %q，实际 %v", c.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != c.want {
+				t.Fatalf("期望 %+v，实际 %+v", c.want, *got)
+			}
+		})
+	}
+}
+
+func TestChildren(t *testing.T) {
+	d := bundledDict(t)
+	roots, err := d.Children("")
+	if err != nil || len(roots) != 34 {
+		t.Fatalf("期望 34 个省级区划，实际 %d (%v)", len(roots), err)
+	}
+	cities, err := d.Children("440000")
+	if err != nil || len(cities) == 0 || cities[0].Level != LevelCity || cities[0].Parent.Code != "440000" {
+		t.Fatalf("广东省下级城市不正确: %v", err)
+	}
+	if _, err := d.Children("999999"); err != ErrNotFound {
+		t.Fatalf("期望 ErrNotFound，实际 %v", err)
+	}
+}
+
+// TestBundledComplete 内置数据集收录全部县级区划：地级区划要么有下级，要么本身不设区县
+func TestBundledComplete(t *testing.T) {
+	d := bundledDict(t)
+	var cities, districts int
+	for _, n := range d.byCode {
+		switch n.Level {
+		case LevelCity:
+			cities++
+			if len(n.Children) == 0 && strings.HasSuffix(n.Code, "00") && !noDistrictCities[n.Code] {
+				t.Errorf("城市 %s %s 未收录区县", n.Code, n.Name)
+			}
+		case LevelDistrict:
+			districts++
+			if !strings.HasPrefix(n.Code, n.Parent.Code[:2]) {
+				t.Errorf("区县 %s %s 不属于 %s", n.Code, n.Name, n.Parent.Code)
+			}
+		}
+	}
+	if cities < 360 || districts < 2800 {
+		t.Fatalf("城市 %d 个，区县 %d 个，数据不完整", cities, districts)
+	}
+}
+
+// noDistrictCities 不设区县的地级市
+var noDistrictCities = map[string]bool{"441900": true, "442000": true, "460400": true, "620200": true}
+
+func TestLoadInvalid(t *testing.T) {
+	cases := map[string]string{
+		"不是 JSON": `{`,
+		"缺少编码":    `[{"name":"北京市"}]`,
+		"编码重复":    `[{"code":"1","name":"a"},{"code":"1","name":"b"}]`,
+		"超过三级": `[{"code":"1","name":"a","children":[{"code":"2","name":"b","children":[
+			{"code":"3","name":"c","children":[{"code":"4","name":"d"}]}]}]}]`,
+	}
+	for name, data := range cases {
+		if _, err := Load([]byte(data)); err == nil {
+			t.Errorf("%s: 期望加载失败", name)
+		}
+	}
+}
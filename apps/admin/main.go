@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"os"
@@ -9,13 +10,21 @@ import (
 	"syscall"
 	"time"
 
+	productmodel "go-ecommerce/apps/product/model"
 	"go-ecommerce/pkg/config"
 	"go-ecommerce/pkg/database"
 	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/money"
 	"go-ecommerce/proto/admin"
+	"go-ecommerce/proto/order"
+	"go-ecommerce/proto/product"
+	"go-ecommerce/proto/promotion"
+	"go-ecommerce/proto/user"
 
+	_ "github.com/mbobakov/grpc-consul-resolver"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -26,11 +35,25 @@ type server struct {
 	dbUser    *gorm.DB
 	dbProduct *gorm.DB
 	dbOrder   *gorm.DB
+
+	userClient      user.UserServiceClient
+	productClient   product.ProductServiceClient
+	orderClient     order.OrderServiceClient
+	promotionClient promotion.PromotionServiceClient
+}
+
+// categoryTree 读取商品库的分类表 (只读，分类的修改统一走 Product Service)
+func (s *server) categoryTree() (*productmodel.CategoryTree, error) {
+	var all []productmodel.Category
+	if err := s.dbProduct.Find(&all).Error; err != nil {
+		return nil, err
+	}
+	return productmodel.NewCategoryTree(all), nil
 }
 
 // --- 数据大屏统计 ---
 func (s *server) GetDashboardStats(ctx context.Context, req *admin.StatsRequest) (*admin.StatsResponse, error) {
-	var totalSales, actualSales float64
+	var totalSales, actualSales money.Cents
 	var oCount, uCount, pCount int64
 
 	// 1. 总订单金额 (GMV)：不管什么状态，所有产生的订单总额
@@ -42,23 +65,50 @@ func (s *server) GetDashboardStats(ctx context.Context, req *admin.StatsRequest)
 	// 3. 基础计数
 	s.dbOrder.Table("orders").Count(&oCount)
 	s.dbUser.Table("users").Count(&uCount)
-	s.dbProduct.Table("products").Count(&pCount)
+	s.dbProduct.Table("products").Where("status <> ?", productmodel.StatusDeleted).Count(&pCount)
 
-	// 4. 统计品类分布
+	// 4. 统计品类分布：按一级分类汇总，分类已删除的商品计入“未分类”
+	var catCounts []struct {
+		CategoryID int64
+		Value      int32
+	}
+	s.dbProduct.Table("products").Where("status <> ?", productmodel.StatusDeleted).Select("category_id, count(*) as value").Group("category_id").Scan(&catCounts)
 	var catStats []*admin.CategoryStat
-	s.dbProduct.Table("products").Select("category as name, count(*) as value").Group("category").Scan(&catStats)
+	if tree, err := s.categoryTree(); err == nil {
+		byName := make(map[string]*admin.CategoryStat)
+		for _, cc := range catCounts {
+			name := "未分类"
+			if root := tree.Root(cc.CategoryID); root != nil {
+				name = root.Name
+			}
+			stat, ok := byName[name]
+			if !ok {
+				stat = &admin.CategoryStat{Name: name}
+				byName[name] = stat
+				catStats = append(catStats, stat)
+			}
+			stat.Value += cc.Value
+		}
+	}
 
 	// 5. 统计销售趋势 (以实际成交为准)
-	var trendStats []*admin.TrendStat
+	var trendRows []struct {
+		Date   string
+		Amount money.Cents
+	}
 	s.dbOrder.Table("orders").
 		Select("DATE_FORMAT(created_at, '%m-%d') as date, SUM(total_amount) as amount").
 		Where("created_at > ?", time.Now().AddDate(0, 0, -7)).
 		Where("status >= ?", 1).
-		Group("date").Order("date asc").Scan(&trendStats)
+		Group("date").Order("date asc").Scan(&trendRows)
+	trendStats := make([]*admin.TrendStat, 0, len(trendRows))
+	for _, t := range trendRows {
+		trendStats = append(trendStats, &admin.TrendStat{Date: t.Date, Amount: int64(t.Amount)})
+	}
 
 	return &admin.StatsResponse{
-		TotalSales:    float32(totalSales),
-		ActualSales:   float32(actualSales),
+		TotalSales:    int64(totalSales),
+		ActualSales:   int64(actualSales),
 		OrderCount:    int32(oCount),
 		UserCount:     int32(uCount),
 		ProductCount:  int32(pCount),
@@ -97,8 +147,9 @@ func (s *server) ListUsers(ctx context.Context, req *admin.ListUsersRequest) (*a
 	return &admin.ListUsersResponse{Users: res, Total: int32(total)}, nil
 }
 
+// DeleteUser 走 User Service 的注销流程，各服务同步匿名化，不再直接删除 users 表
 func (s *server) DeleteUser(ctx context.Context, req *admin.DeleteUserRequest) (*admin.DeleteUserResponse, error) {
-	err := s.dbUser.Table("users").Where("id = ?", req.UserId).Delete(nil).Error
+	_, err := s.userClient.DeleteAccount(ctx, &user.DeleteAccountRequest{UserId: req.UserId, ByAdmin: true})
 	return &admin.DeleteUserResponse{Success: err == nil}, err
 }
 
@@ -112,22 +163,40 @@ func (s *server) ToggleUserStatus(ctx context.Context, req *admin.ToggleStatusRe
 
 func (s *server) ListAllProducts(ctx context.Context, req *admin.ListAllProductsRequest) (*admin.ListAllProductsResponse, error) {
 	var prods []struct {
-		ID       int64
-		Name     string
-		Price    float32
-		Stock    int32
-		Picture  string
-		Category string
+		ID         int64
+		Name       string
+		Price      money.Cents
+		Stock      int32
+		Picture    string
+		CategoryID int64
+		Status     string
+		OnShelfAt  *time.Time
+		OffShelfAt *time.Time
 	}
 
+	tree, err := s.categoryTree()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询分类失败: %v", err)
+	}
 	query := s.dbProduct.Debug().Table("products")
-	if req.Category != "" {
-		query = query.Where("category = ?", req.Category)
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	} else {
+		query = query.Where("status <> ?", productmodel.StatusDeleted)
+	}
+	if req.CategoryId > 0 {
+		if _, ok := tree.Get(req.CategoryId); !ok {
+			return nil, status.Error(codes.NotFound, "分类不存在")
+		}
+		query = query.Where("category_id IN ?", tree.Descendants(req.CategoryId))
 	}
 	var total int64
 	query.Count(&total)
 
-	err := query.Limit(int(req.PageSize)).
+	// 库存属于 SKU，商品库存为未删除规格之和
+	err = query.Select("products.id, products.name, products.price, products.picture, products.category_id, products.status, products.on_shelf_at, products.off_shelf_at, "+
+		"(SELECT COALESCE(SUM(skus.stock), 0) FROM skus WHERE skus.product_id = products.id AND skus.status <> ?) AS stock", productmodel.StatusDeleted).
+		Limit(int(req.PageSize)).
 		Offset(int((req.Page - 1) * req.PageSize)).
 		Find(&prods).Error
 
@@ -137,41 +206,291 @@ func (s *server) ListAllProducts(ctx context.Context, req *admin.ListAllProducts
 
 	var res []*admin.AdminProductInfo
 	for _, p := range prods {
-		res = append(res, &admin.AdminProductInfo{
-			Id:       p.ID,
-			Name:     p.Name,
-			Price:    p.Price,
-			Stock:    p.Stock,
-			Picture:  p.Picture,
-			Category: p.Category,
-		})
+		info := &admin.AdminProductInfo{
+			Id:         p.ID,
+			Name:       p.Name,
+			Price:      int64(p.Price),
+			Stock:      p.Stock,
+			Picture:    p.Picture,
+			CategoryId: p.CategoryID,
+			Status:     p.Status,
+		}
+		if p.OnShelfAt != nil {
+			info.OnShelfAt = p.OnShelfAt.Unix()
+		}
+		if p.OffShelfAt != nil {
+			info.OffShelfAt = p.OffShelfAt.Unix()
+		}
+		if c, ok := tree.Get(p.CategoryID); ok {
+			info.Category = c.Name
+		}
+		res = append(res, info)
 	}
 	return &admin.ListAllProductsResponse{Products: res, Total: int32(total)}, nil
 }
 
+// CreateProduct 创建商品及其规格
+func (s *server) CreateProduct(ctx context.Context, req *admin.CreateProductRequest) (*admin.CreateProductResponse, error) {
+	if len(req.Skus) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "至少需要一个规格")
+	}
+	in := &product.CreateProductRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Picture:     req.Picture,
+		Price:       req.Price,
+		CategoryId:  req.CategoryId,
+		Specs:       toProductSpecs(req.Specs),
+		Status:      req.Status,
+	}
+	for _, sku := range req.Skus {
+		in.Skus = append(in.Skus, &product.CreateSkuRequest{
+			Name: sku.Name, Price: sku.Price, Stock: sku.Stock, Picture: sku.Picture, Weight: sku.Weight,
+			SpecValueIds: sku.SpecValueIds, SpecValues: sku.SpecValues,
+		})
+	}
+	resp, err := s.productClient.CreateProduct(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.CreateProductResponse{Id: resp.Id}, nil
+}
+
+// UpdateProduct 修改商品信息；库存属于 SKU，只有单规格商品可以在这里直接设置
 func (s *server) UpdateProduct(ctx context.Context, req *admin.UpdateProductRequest) (*admin.UpdateProductResponse, error) {
-	updates := make(map[string]interface{})
+	in := &product.UpdateProductRequest{
+		Id:          req.Id,
+		Description: req.Description,
+		Picture:     req.Picture,
+		CategoryId:  req.CategoryId,
+	}
 	if req.Name != "" {
-		updates["name"] = req.Name
+		in.Name = &req.Name
+	}
+	if req.Price != 0 {
+		in.Price = &req.Price
+	}
+	if _, err := s.productClient.UpdateProduct(ctx, in); err != nil {
+		return nil, err
 	}
-	updates["price"] = req.Price
-	updates["stock"] = req.Stock
 
-	err := s.dbProduct.Table("products").Where("id = ?", req.Id).Updates(updates).Error
-	return &admin.UpdateProductResponse{Success: err == nil}, err
+	if req.Stock != nil {
+		var skuIds []int64
+		if err := s.dbProduct.Table("skus").Where("product_id = ? AND status <> ?", req.Id, productmodel.StatusDeleted).Pluck("id", &skuIds).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "查询数据库失败: %v", err)
+		}
+		if len(skuIds) != 1 {
+			return nil, status.Error(codes.FailedPrecondition, "该商品有多个规格，请按规格设置库存")
+		}
+		if _, err := s.productClient.SetSkuStock(ctx, &product.SetSkuStockRequest{SkuId: skuIds[0], Stock: req.GetStock()}); err != nil {
+			return nil, err
+		}
+	}
+	return &admin.UpdateProductResponse{Success: true}, nil
 }
 
 func (s *server) DeleteProduct(ctx context.Context, req *admin.DeleteProductRequest) (*admin.DeleteProductResponse, error) {
-	err := s.dbProduct.Table("products").Where("id = ?", req.Id).Delete(nil).Error
-	return &admin.DeleteProductResponse{Success: err == nil}, err
+	resp, err := s.productClient.DeleteProduct(ctx, &product.DeleteProductRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.DeleteProductResponse{Success: resp.Success}, nil
 }
 
+// BatchUpdatePrice 按比例批量调价 (含子分类)，舍入规则见 Product Service
 func (s *server) BatchUpdatePrice(ctx context.Context, req *admin.BatchPriceRequest) (*admin.BatchPriceResponse, error) {
-	// GORM 表达式更新：price = price * ratio
-	err := s.dbProduct.Table("products").
-		Where("category = ?", req.Category).
-		Update("price", gorm.Expr("price * ?", req.Ratio)).Error
-	return &admin.BatchPriceResponse{Success: err == nil}, err
+	_, err := s.productClient.BatchUpdatePrice(ctx, &product.BatchUpdatePriceRequest{CategoryId: req.CategoryId, RatioBp: req.RatioBp})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.BatchPriceResponse{Success: true}, nil
+}
+
+// --- 规格 (SKU) 管理 ---
+
+func toProductSpecs(in []*admin.AdminSpecDimension) []*product.SpecDimension {
+	var out []*product.SpecDimension
+	for _, dim := range in {
+		d := &product.SpecDimension{Id: dim.Id, Name: dim.Name}
+		for _, v := range dim.Values {
+			d.Values = append(d.Values, &product.SpecValue{Id: v.Id, Value: v.Value})
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func toAdminSpecs(in []*product.SpecDimension) []*admin.AdminSpecDimension {
+	var out []*admin.AdminSpecDimension
+	for _, dim := range in {
+		d := &admin.AdminSpecDimension{Id: dim.Id, Name: dim.Name}
+		for _, v := range dim.Values {
+			d.Values = append(d.Values, &admin.AdminSpecValue{Id: v.Id, Value: v.Value})
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// ListSkus 规格列表与规格矩阵 (规格矩阵由 Product Service 解析)
+func (s *server) ListSkus(ctx context.Context, req *admin.ListSkusRequest) (*admin.ListSkusResponse, error) {
+	detail, err := s.productClient.GetProductDetail(ctx, &product.GetProductRequest{Id: req.ProductId})
+	if err != nil {
+		return nil, err
+	}
+	specValues := make(map[int64][]int64, len(detail.Skus))
+	for _, sku := range detail.Skus {
+		specValues[sku.SkuId] = sku.SpecValueIds
+	}
+
+	var skus []struct {
+		ID        int64
+		ProductID int64
+		Name      string
+		Price     money.Cents
+		Stock     int32
+		Picture   string
+		Weight    int32
+		Status    string
+	}
+	if err := s.dbProduct.Table("skus").Where("product_id = ? AND status <> ?", req.ProductId, productmodel.StatusDeleted).Order("id ASC").Find(&skus).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "查询数据库失败: %v", err)
+	}
+	res := &admin.ListSkusResponse{Specs: toAdminSpecs(detail.Specs)}
+	for _, sku := range skus {
+		res.Skus = append(res.Skus, &admin.AdminSkuInfo{
+			Id:           sku.ID,
+			ProductId:    sku.ProductID,
+			Name:         sku.Name,
+			Price:        int64(sku.Price),
+			Stock:        sku.Stock,
+			Picture:      sku.Picture,
+			Weight:       sku.Weight,
+			SpecValueIds: specValues[sku.ID],
+			Status:       sku.Status,
+		})
+	}
+	return res, nil
+}
+
+// SaveSku 新增规格 (id 为 0) 或修改规格信息；修改时不改库存
+func (s *server) SaveSku(ctx context.Context, req *admin.SaveSkuRequest) (*admin.SaveSkuResponse, error) {
+	sku := req.Sku
+	if sku == nil {
+		return nil, status.Error(codes.InvalidArgument, "规格不能为空")
+	}
+	if sku.Id == 0 {
+		resp, err := s.productClient.CreateSku(ctx, &product.CreateSkuRequest{
+			ProductId: sku.ProductId, Name: sku.Name, Price: sku.Price, Stock: sku.Stock, Picture: sku.Picture, Weight: sku.Weight,
+			SpecValueIds: sku.SpecValueIds,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &admin.SaveSkuResponse{Id: resp.Id}, nil
+	}
+	in := &product.UpdateSkuRequest{
+		Id: sku.Id, Price: &sku.Price, Picture: &sku.Picture, Weight: &sku.Weight, SpecValueIds: sku.SpecValueIds,
+	}
+	if sku.Name != "" {
+		in.Name = &sku.Name
+	}
+	if _, err := s.productClient.UpdateSku(ctx, in); err != nil {
+		return nil, err
+	}
+	return &admin.SaveSkuResponse{Id: sku.Id}, nil
+}
+
+func (s *server) SetProductSpecs(ctx context.Context, req *admin.SetProductSpecsRequest) (*admin.SetProductSpecsResponse, error) {
+	resp, err := s.productClient.SetProductSpecs(ctx, &product.SetProductSpecsRequest{ProductId: req.ProductId, Specs: toProductSpecs(req.Specs)})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetProductSpecsResponse{Specs: toAdminSpecs(resp.Specs)}, nil
+}
+
+func (s *server) SetProductStatus(ctx context.Context, req *admin.SetProductStatusRequest) (*admin.SetProductStatusResponse, error) {
+	resp, err := s.productClient.SetProductStatus(ctx, &product.SetProductStatusRequest{
+		ProductId: req.ProductId, Status: req.Status, OnShelfAt: req.OnShelfAt, OffShelfAt: req.OffShelfAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetProductStatusResponse{Success: resp.Success}, nil
+}
+
+func (s *server) SetSkuStatus(ctx context.Context, req *admin.SetSkuStatusRequest) (*admin.SetSkuStatusResponse, error) {
+	resp, err := s.productClient.SetSkuStatus(ctx, &product.SetSkuStatusRequest{SkuId: req.SkuId, Status: req.Status})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetSkuStatusResponse{Success: resp.Success}, nil
+}
+
+func (s *server) SetSkuStock(ctx context.Context, req *admin.SetSkuStockRequest) (*admin.SetSkuStockResponse, error) {
+	resp, err := s.productClient.SetSkuStock(ctx, &product.SetSkuStockRequest{SkuId: req.SkuId, Stock: req.Stock})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetSkuStockResponse{Success: resp.Success}, nil
+}
+
+// --- 搜索索引 ---
+
+// ReindexSearch 全量重建商品搜索索引 (由 Product Service 在后台执行)
+func (s *server) ReindexSearch(ctx context.Context, req *admin.ReindexSearchRequest) (*admin.ReindexSearchResponse, error) {
+	resp, err := s.productClient.ReindexProducts(ctx, &product.ReindexProductsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ReindexSearchResponse{Started: resp.Started}, nil
+}
+
+// --- 分类管理 ---
+
+func toCategoryNode(c *product.CategoryInfo, counts map[int64]int64) *admin.CategoryNode {
+	node := &admin.CategoryNode{Id: c.Id, Name: c.Name, ParentId: c.ParentId, Sort: c.Sort, ProductCount: counts[c.Id]}
+	for _, child := range c.Children {
+		node.Children = append(node.Children, toCategoryNode(child, counts))
+	}
+	return node
+}
+
+func (s *server) ListCategories(ctx context.Context, req *admin.ListCategoriesRequest) (*admin.ListCategoriesResponse, error) {
+	resp, err := s.productClient.ListCategories(ctx, &product.ListCategoriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		CategoryID int64
+		Total      int64
+	}
+	s.dbProduct.Table("products").Where("status <> ?", productmodel.StatusDeleted).Select("category_id, count(*) as total").Group("category_id").Scan(&rows)
+	counts := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		counts[r.CategoryID] = r.Total
+	}
+	res := &admin.ListCategoriesResponse{}
+	for _, c := range resp.Categories {
+		res.Categories = append(res.Categories, toCategoryNode(c, counts))
+	}
+	return res, nil
+}
+
+func (s *server) SaveCategory(ctx context.Context, req *admin.SaveCategoryRequest) (*admin.SaveCategoryResponse, error) {
+	resp, err := s.productClient.SaveCategory(ctx, &product.CategoryInfo{Id: req.Id, Name: req.Name, ParentId: req.ParentId, Sort: req.Sort})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SaveCategoryResponse{Id: resp.Id}, nil
+}
+
+func (s *server) DeleteCategory(ctx context.Context, req *admin.DeleteCategoryRequest) (*admin.DeleteCategoryResponse, error) {
+	resp, err := s.productClient.DeleteCategory(ctx, &product.DeleteCategoryRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.DeleteCategoryResponse{Success: resp.Success}, nil
 }
 
 // --- 订单管理 ---
@@ -180,6 +499,136 @@ func (s *server) ShipOrder(ctx context.Context, req *admin.ShipOrderRequest) (*a
 	return &admin.ShipOrderResponse{Success: err == nil}, err
 }
 
+// --- 运费规则 (由 Order Service 维护) ---
+
+func toShippingRuleInfo(r *order.ShippingRuleInfo) *admin.ShippingRuleInfo {
+	return &admin.ShippingRuleInfo{
+		Id:             r.Id,
+		Name:           r.Name,
+		Regions:        r.Regions,
+		Deliverable:    r.Deliverable,
+		Mode:           r.Mode,
+		FirstUnit:      r.FirstUnit,
+		FirstFee:       r.FirstFee,
+		AdditionalUnit: r.AdditionalUnit,
+		AdditionalFee:  r.AdditionalFee,
+		FreeThreshold:  r.FreeThreshold,
+		Priority:       r.Priority,
+		Enabled:        r.Enabled,
+	}
+}
+
+func (s *server) ListShippingRules(ctx context.Context, req *admin.ListShippingRulesRequest) (*admin.ListShippingRulesResponse, error) {
+	resp, err := s.orderClient.ListShippingRules(ctx, &order.ListShippingRulesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res := &admin.ListShippingRulesResponse{}
+	for _, r := range resp.Rules {
+		res.Rules = append(res.Rules, toShippingRuleInfo(r))
+	}
+	return res, nil
+}
+
+func (s *server) SaveShippingRule(ctx context.Context, req *admin.SaveShippingRuleRequest) (*admin.SaveShippingRuleResponse, error) {
+	in := req.Rule
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "规则不能为空")
+	}
+	resp, err := s.orderClient.SaveShippingRule(ctx, &order.ShippingRuleInfo{
+		Id:             in.Id,
+		Name:           in.Name,
+		Regions:        in.Regions,
+		Deliverable:    in.Deliverable,
+		Mode:           in.Mode,
+		FirstUnit:      in.FirstUnit,
+		FirstFee:       in.FirstFee,
+		AdditionalUnit: in.AdditionalUnit,
+		AdditionalFee:  in.AdditionalFee,
+		FreeThreshold:  in.FreeThreshold,
+		Priority:       in.Priority,
+		Enabled:        in.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SaveShippingRuleResponse{Id: resp.Id}, nil
+}
+
+func (s *server) DeleteShippingRule(ctx context.Context, req *admin.DeleteShippingRuleRequest) (*admin.DeleteShippingRuleResponse, error) {
+	resp, err := s.orderClient.DeleteShippingRule(ctx, &order.DeleteShippingRuleRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.DeleteShippingRuleResponse{Success: resp.Success}, nil
+}
+
+// --- 优惠券 ---
+
+func toCouponInfo(c *promotion.CouponInfo) *admin.CouponInfo {
+	return &admin.CouponInfo{
+		Id:             c.Id,
+		Name:           c.Name,
+		Type:           c.Type,
+		Amount:         c.Amount,
+		Percent:        c.Percent,
+		MaxDiscount:    c.MaxDiscount,
+		Threshold:      c.Threshold,
+		Scope:          c.Scope,
+		ScopeIds:       c.ScopeIds,
+		TotalQuantity:  c.TotalQuantity,
+		IssuedQuantity: c.IssuedQuantity,
+		ClaimLimit:     c.ClaimLimit,
+		UseLimit:       c.UseLimit,
+		StartAt:        c.StartAt,
+		EndAt:          c.EndAt,
+		ValidDays:      c.ValidDays,
+		Enabled:        c.Enabled,
+	}
+}
+
+func (s *server) ListCoupons(ctx context.Context, req *admin.ListCouponsRequest) (*admin.ListCouponsResponse, error) {
+	resp, err := s.promotionClient.ListCoupons(ctx, &promotion.ListCouponsRequest{Page: req.Page, PageSize: req.PageSize})
+	if err != nil {
+		return nil, err
+	}
+	res := &admin.ListCouponsResponse{Total: resp.Total}
+	for _, c := range resp.Coupons {
+		res.Coupons = append(res.Coupons, toCouponInfo(c))
+	}
+	return res, nil
+}
+
+func (s *server) SaveCoupon(ctx context.Context, req *admin.SaveCouponRequest) (*admin.SaveCouponResponse, error) {
+	in := req.Coupon
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "优惠券不能为空")
+	}
+	// 已发放数量由 Promotion Service 维护，不接受后台修改
+	resp, err := s.promotionClient.SaveCoupon(ctx, &promotion.CouponInfo{
+		Id:            in.Id,
+		Name:          in.Name,
+		Type:          in.Type,
+		Amount:        in.Amount,
+		Percent:       in.Percent,
+		MaxDiscount:   in.MaxDiscount,
+		Threshold:     in.Threshold,
+		Scope:         in.Scope,
+		ScopeIds:      in.ScopeIds,
+		TotalQuantity: in.TotalQuantity,
+		ClaimLimit:    in.ClaimLimit,
+		UseLimit:      in.UseLimit,
+		StartAt:       in.StartAt,
+		EndAt:         in.EndAt,
+		ValidDays:     in.ValidDays,
+		Enabled:       in.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SaveCouponResponse{Id: resp.Id}, nil
+}
+
 func main() {
 	c, err := config.LoadConfig(".")
 	if err != nil {
@@ -205,14 +654,40 @@ func main() {
 		log.Fatalf("监听失败: %v", err)
 	}
 
-	s := grpc.NewServer()
-	admin.RegisterAdminServiceServer(s, &server{dbUser: dbU, dbProduct: dbP, dbOrder: dbO})
-	reflection.Register(s)
-
 	consulAddr := os.Getenv("CONSUL_ADDRESS")
 	if consulAddr == "" {
 		consulAddr = c.Consul.Address
 	}
+
+	userConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", consulAddr, "user-service"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	productConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", consulAddr, "product-service"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+
+	orderConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", consulAddr, "order-service"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	promotionConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", consulAddr, "promotion-service"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+
+	s := grpc.NewServer()
+	admin.RegisterAdminServiceServer(s, &server{
+		dbUser:          dbU,
+		dbProduct:       dbP,
+		dbOrder:         dbO,
+		userClient:      user.NewUserServiceClient(userConn),
+		productClient:   product.NewProductServiceClient(productConn),
+		orderClient:     order.NewOrderServiceClient(orderConn),
+		promotionClient: promotion.NewPromotionServiceClient(promotionConn),
+	})
+	reflection.Register(s)
 	discovery.RegisterService("admin-service", 50058, consulAddr)
 
 	log.Println("Admin Service 启动成功: :50058")
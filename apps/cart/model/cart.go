@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"go-ecommerce/pkg/money"
+)
+
+// Cart 购物车持久化记录
+// Redis 仍是购物车的主存储，每次修改后整车写穿到 MySQL，Redis 数据丢失时据此恢复
+type Cart struct {
+	ID           uint       `gorm:"primaryKey"`
+	OwnerKey     string     `gorm:"type:varchar(64);uniqueIndex"` // 与 Redis key 一致，如 cart:1、cart:guest:{gid}
+	UserID       int64      `gorm:"index"`                        // 游客购物车为 0
+	GuestID      string     `gorm:"type:varchar(32)"`
+	ItemCount    int        `gorm:"type:int"`
+	LastActiveAt time.Time  `gorm:"index"` // 最后一次修改时间
+	AbandonedAt  *time.Time // 已发送 cart.abandoned 事件的时间，购物车再次修改时清空
+	Items        []CartItem `gorm:"foreignKey:CartID"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// CartItem 购物车行 (数量与 Redis 中的行信息)
+type CartItem struct {
+	ID       uint        `gorm:"primaryKey"`
+	CartID   uint        `gorm:"uniqueIndex:uk_cart_sku"`
+	SkuID    int64       `gorm:"uniqueIndex:uk_cart_sku"`
+	Quantity int         `gorm:"type:int"`
+	Price    money.Cents `gorm:"type:decimal(10,2)"` // 加入购物车时的价格
+	Selected bool
+	AddedAt  int64 // 加入时间 (Unix 秒)
+}
+
+// AbandonedEvent cart.abandoned 事件：登录用户的非空购物车闲置超过阈值
+type AbandonedEvent struct {
+	UserID       int64           `json:"user_id"`
+	Items        []AbandonedItem `json:"items"`
+	LastActiveAt time.Time       `json:"last_active_at"`
+	IdleMinutes  int64           `json:"idle_minutes"`
+}
+
+type AbandonedItem struct {
+	SkuID    int64       `json:"sku_id"`
+	Quantity int         `json:"quantity"`
+	Price    money.Cents `json:"price"` // 加入购物车时的价格 (分)
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 数量校验失败的原因
+var (
+	ErrQuantityLimit = errors.New("超过单个商品购买上限")
+	ErrItemsLimit    = errors.New("超过购物车商品种类上限")
+	ErrStockLimit    = errors.New("库存不足")
+)
+
+// Limits 购物车数量限制
+type Limits struct {
+	MaxQuantity int64 // 单个商品最多购买件数
+	MaxItems    int64 // 购物车最多商品种类
+}
+
+// Next 在已有数量 current 上累加 (add 为 true) 或直接设置为 quantity，返回校验单品上限后的新数量
+// 按 int64 计算，累加结果超出上限时在转换为 int32 之前就拒绝
+func (l Limits) Next(current, quantity int64, add bool) (int64, error) {
+	next := quantity
+	if add {
+		next = current + quantity
+	}
+	if next < 0 || next > l.MaxQuantity {
+		return 0, ErrQuantityLimit
+	}
+	return next, nil
+}
+
+// setQuantityScript 在 Redis 中原子地完成读取、校验与写入，避免并发加购丢失更新
+// KEYS: 1 数量哈希 2 行信息哈希
+// ARGV: 1 field 2 数量 3 是否累加 (1/0) 4 单品上限 5 种类上限 6 库存 (<=0 不校验)
+// 返回 {结果, 原数量}；结果 >=0 为新数量 (0 表示已移除)，-1 超过单品上限，-2 超过种类上限，-3 库存不足
+var setQuantityScript = redis.NewScript(`
+local cur = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+local q = tonumber(ARGV[2])
+if ARGV[3] == '1' then
+	q = cur + q
+end
+if q < 0 or q > tonumber(ARGV[4]) then
+	return {-1, cur}
+end
+if q == 0 then
+	redis.call('HDEL', KEYS[1], ARGV[1])
+	redis.call('HDEL', KEYS[2], ARGV[1])
+	return {0, cur}
+end
+local stock = tonumber(ARGV[6])
+if stock > 0 and q > stock then
+	return {-3, cur}
+end
+if cur == 0 and redis.call('HLEN', KEYS[1]) >= tonumber(ARGV[5]) then
+	return {-2, cur}
+end
+redis.call('HSET', KEYS[1], ARGV[1], q)
+return {q, cur}
+`)
+
+// SetQuantity 原子地把 cartKey 中 field 的数量累加或设置为 quantity，数量为 0 时连同 metaKey 中的行信息一并移除
+// stock 大于 0 时同时校验库存；返回新数量与修改前的数量 (为 0 表示新加入的商品)
+func SetQuantity(ctx context.Context, rdb redis.Scripter, cartKey, metaKey, field string, quantity int64, add bool, l Limits, stock int64) (next, prev int64, err error) {
+	addFlag := 0
+	if add {
+		addFlag = 1
+	}
+	res, err := setQuantityScript.Run(ctx, rdb, []string{cartKey, metaKey},
+		field, quantity, addFlag, l.MaxQuantity, l.MaxItems, stock).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(res) != 2 {
+		return 0, 0, errors.New("购物车脚本返回值格式错误")
+	}
+	next, prev = res[0], res[1]
+	switch next {
+	case -1:
+		return 0, prev, ErrQuantityLimit
+	case -2:
+		return 0, prev, ErrItemsLimit
+	case -3:
+		return 0, prev, ErrStockLimit
+	}
+	return next, prev, nil
+}
@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestLimitsNext(t *testing.T) {
+	l := Limits{MaxQuantity: 99, MaxItems: 100}
+	cases := []struct {
+		name     string
+		current  int64
+		quantity int64
+		add      bool
+		want     int64
+		wantErr  error
+	}{
+		{"新加入", 0, 3, true, 3, nil},
+		{"累加", 5, 3, true, 8, nil},
+		{"累加到上限", 90, 9, true, 99, nil},
+		{"累加超过上限", 90, 10, true, 0, ErrQuantityLimit},
+		{"设置", 5, 20, false, 20, nil},
+		{"设置为上限", 5, 99, false, 99, nil},
+		{"设置超过上限", 5, 100, false, 0, ErrQuantityLimit},
+		{"设置为 0 表示移除", 5, 0, false, 0, nil},
+		{"设置为负数", 5, -1, false, 0, ErrQuantityLimit},
+		// int32 相加会溢出为负数，按 int64 计算后应被上限拒绝
+		{"累加超过 int32", math.MaxInt32, math.MaxInt32, true, 0, ErrQuantityLimit},
+		{"设置为 int32 最大值", 0, math.MaxInt32, false, 0, ErrQuantityLimit},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := l.Next(c.current, c.quantity, c.add)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("期望错误 %v，实际 %v", c.wantErr, err)
+			}
+			if got != c.want {
+				t.Fatalf("期望 %d，实际 %d", c.want, got)
+			}
+		})
+	}
+}
+
+// testRedis 连接 CART_TEST_REDIS 指定的 Redis (如 localhost:6379)，未设置时跳过
+// 使用独立的 db 15，测试前后清空
+func testRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	addr := os.Getenv("CART_TEST_REDIS")
+	if addr == "" {
+		t.Skip("未设置 CART_TEST_REDIS，跳过 Redis 测试")
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: addr, DB: 15})
+	ctx := context.Background()
+	if err := rdb.FlushDB(ctx).Err(); err != nil {
+		t.Fatalf("连接 Redis 失败: %v", err)
+	}
+	t.Cleanup(func() {
+		rdb.FlushDB(ctx)
+		rdb.Close()
+	})
+	return rdb
+}
+
+func TestSetQuantity(t *testing.T) {
+	rdb := testRedis(t)
+	ctx := context.Background()
+	l := Limits{MaxQuantity: 10, MaxItems: 2}
+	const key, meta = "cart:1", "cart:1:meta"
+
+	set := func(field string, quantity int64, add bool, stock int64) (int64, int64, error) {
+		return SetQuantity(ctx, rdb, key, meta, field, quantity, add, l, stock)
+	}
+
+	// 新加入：原数量为 0
+	if next, prev, err := set("1", 3, true, 0); err != nil || next != 3 || prev != 0 {
+		t.Fatalf("加入: next=%d prev=%d err=%v", next, prev, err)
+	}
+	// 累加
+	if next, prev, err := set("1", 4, true, 0); err != nil || next != 7 || prev != 3 {
+		t.Fatalf("累加: next=%d prev=%d err=%v", next, prev, err)
+	}
+	// 累加超过单品上限，数量不变
+	if _, _, err := set("1", 4, true, 0); !errors.Is(err, ErrQuantityLimit) {
+		t.Fatalf("期望超过单品上限，实际 %v", err)
+	}
+	if v, _ := rdb.HGet(ctx, key, "1").Int64(); v != 7 {
+		t.Fatalf("超限后数量应保持 7，实际 %d", v)
+	}
+	// 设置
+	if next, _, err := set("1", 10, false, 0); err != nil || next != 10 {
+		t.Fatalf("设置: next=%d err=%v", next, err)
+	}
+	if _, _, err := set("1", 11, false, 0); !errors.Is(err, ErrQuantityLimit) {
+		t.Fatalf("期望超过单品上限，实际 %v", err)
+	}
+	// 库存
+	if _, _, err := set("1", 6, false, 5); !errors.Is(err, ErrStockLimit) {
+		t.Fatalf("期望库存不足，实际 %v", err)
+	}
+	// 种类上限：已有商品不受限制，新商品超过上限被拒绝
+	if _, _, err := set("2", 1, true, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := set("3", 1, true, 0); !errors.Is(err, ErrItemsLimit) {
+		t.Fatalf("期望超过种类上限，实际 %v", err)
+	}
+	if _, _, err := set("2", 1, true, 0); err != nil {
+		t.Fatalf("已有商品累加不受种类上限限制: %v", err)
+	}
+	// 设置为 0 时连同行信息一并移除
+	rdb.HSet(ctx, meta, "2", "{}")
+	if next, prev, err := set("2", 0, false, 0); err != nil || next != 0 || prev != 2 {
+		t.Fatalf("移除: next=%d prev=%d err=%v", next, prev, err)
+	}
+	if n, _ := rdb.HExists(ctx, key, "2").Result(); n {
+		t.Fatal("数量为 0 的商品应从购物车移除")
+	}
+	if n, _ := rdb.HExists(ctx, meta, "2").Result(); n {
+		t.Fatal("移除商品时应同时删除行信息")
+	}
+	// 移除后可以加入新商品
+	if _, _, err := set("3", 1, true, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 并发加购不丢失更新，也不会超过单品上限
+func TestSetQuantityConcurrent(t *testing.T) {
+	rdb := testRedis(t)
+	ctx := context.Background()
+	l := Limits{MaxQuantity: 50, MaxItems: 100}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	ok := 0
+	for i := 0; i < 80; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := SetQuantity(ctx, rdb, "cart:2", "cart:2:meta", "1", 1, true, l, 0); err == nil {
+				mu.Lock()
+				ok++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	got, _ := rdb.HGet(ctx, "cart:2", "1").Int64()
+	if got != 50 || ok != 50 {
+		t.Fatalf("期望成功 50 次且数量为 50，实际成功 %d 次、数量 %d", ok, got)
+	}
+}
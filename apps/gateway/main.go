@@ -18,6 +18,7 @@ import (
 	"go-ecommerce/proto/order"
 	"go-ecommerce/proto/payment"
 	"go-ecommerce/proto/product"
+	"go-ecommerce/proto/promotion"
 	"go-ecommerce/proto/review"
 	"go-ecommerce/proto/user"
 
@@ -34,12 +35,14 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 )
 
 // Sentinel 限流资源名常量，用于秒杀接口的流量控制
 const ResSeckill = "seckill_api"
 
+// 第三方登录 state Cookie 名称
+const oauthStateCookie = "oauth_state"
+
 // initSentinel 初始化 Sentinel 流控组件并加载硬编码规则
 func initSentinel() {
 	err := sentinel.InitDefault()
@@ -79,6 +82,12 @@ func main() {
 			c.Service.Port = p
 		}
 	}
+	if v := os.Getenv("CART_GUEST_SECRET"); v != "" {
+		c.Cart.GuestSecret = v
+	}
+	if c.Cart.GuestSecret == "" {
+		log.Fatalf("未配置游客购物车签名密钥 (cart.guest_secret)")
+	}
 
 	// 2. 初始化全链路追踪 (Jaeger)
 	jaegerAddr := "jaeger:4318"
@@ -121,6 +130,36 @@ func main() {
 	paymentClient := payment.NewPaymentServiceClient(dial("payment-service"))
 	addressClient := address.NewAddressServiceClient(dial("address-service"))
 	reviewClient := review.NewReviewServiceClient(dial("review-service"))
+	promotionClient := promotion.NewPromotionServiceClient(dial("promotion-service"))
+
+	// 游客购物车：Cookie 有效期与 Cart Service 中游客购物车的过期时间一致
+	guestTTL := c.Cart.GuestTTL
+	if guestTTL <= 0 {
+		guestTTL = 7 * 24
+	}
+	guestCartMaxAge := guestTTL * 3600
+
+	// cartOwner 购物车归属：已登录返回 userId，否则返回游客购物车 ID
+	cartOwner := func(ctx *gin.Context) (int64, string) {
+		if v, ok := ctx.Get("userId"); ok {
+			return v.(int64), ""
+		}
+		return 0, ctx.GetString("guestCartId")
+	}
+
+	// mergeGuestCart 登录成功后把游客购物车合并到用户购物车
+	// 合并失败不影响登录，保留 Cookie 以便下次登录重试
+	mergeGuestCart := func(ctx *gin.Context, userId int64) {
+		guestId, ok := middleware.GuestCartId(ctx, c.Cart.GuestSecret)
+		if !ok || userId <= 0 {
+			return
+		}
+		if _, err := cartClient.MergeCart(ctx.Request.Context(), &cart.MergeCartRequest{GuestId: guestId, UserId: userId}); err != nil {
+			log.Printf("[Gateway] 合并游客购物车失败: %v", err)
+			return
+		}
+		middleware.ClearGuestCart(ctx)
+	}
 
 	// ==========================================
 	// 5. 启动 HTTP 服务 (Gin)
@@ -152,6 +191,7 @@ func main() {
 				response.Error(ctx, http.StatusInternalServerError, err.Error())
 				return
 			}
+			mergeGuestCart(ctx, resp.UserId)
 			response.Success(ctx, resp)
 		})
 
@@ -174,34 +214,168 @@ func main() {
 				Username: req.Username, Password: req.Password, Mobile: req.Mobile, Nickname: req.Nickname,
 			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 找回密码：申请重置令牌
+		v1.POST("/user/password/reset/request", func(ctx *gin.Context) {
+			var req struct {
+				Username string `json:"username" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := userClient.RequestPasswordReset(ctx.Request.Context(), &user.RequestPasswordResetRequest{Username: req.Username})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 找回密码：凭令牌设置新密码
+		v1.POST("/user/password/reset", func(ctx *gin.Context) {
+			var req struct {
+				Token       string `json:"token" binding:"required"`
+				NewPassword string `json:"new_password" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := userClient.ResetPassword(ctx.Request.Context(), &user.ResetPasswordRequest{Token: req.Token, NewPassword: req.NewPassword})
+			if err != nil {
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
 		})
 
+		// 第三方登录：生成 IdP 授权地址 (已登录时为绑定账号，reauth=1 时为注销前重新认证)
+		// state 同时写入 Cookie，回调时比对，防止登录 CSRF
+		v1.GET("/user/oauth/:provider/start", middleware.OptionalAuthMiddleware(), func(ctx *gin.Context) {
+			req := &user.OAuthStartRequest{Provider: ctx.Param("provider")}
+			v, ok := ctx.Get("userId")
+			switch {
+			case ctx.Query("reauth") == "1" && !ok:
+				response.Error(ctx, http.StatusUnauthorized, "请先登录")
+				return
+			case ctx.Query("reauth") == "1":
+				req.ReauthUserId = v.(int64)
+			case ok:
+				req.LinkUserId = v.(int64)
+			}
+			resp, err := userClient.OAuthStart(ctx.Request.Context(), req)
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			ctx.SetSameSite(http.SameSiteLaxMode)
+			ctx.SetCookie(oauthStateCookie, resp.State, 600, "/api/v1/user/oauth", "", false, true)
+			if ctx.Query("redirect") == "1" {
+				ctx.Redirect(http.StatusFound, resp.AuthUrl)
+				return
+			}
+			response.Success(ctx, gin.H{"auth_url": resp.AuthUrl})
+		})
+
+		// 第三方登录：IdP 回调
+		v1.GET("/user/oauth/:provider/callback", func(ctx *gin.Context) {
+			if e := ctx.Query("error"); e != "" {
+				response.Error(ctx, http.StatusUnauthorized, "第三方登录已取消: "+e)
+				return
+			}
+			state := ctx.Query("state")
+			cookieState, err := ctx.Cookie(oauthStateCookie)
+			if err != nil || state == "" || cookieState != state {
+				response.Error(ctx, http.StatusBadRequest, "登录请求已失效，请重新发起")
+				return
+			}
+			ctx.SetCookie(oauthStateCookie, "", -1, "/api/v1/user/oauth", "", false, true)
+
+			resp, err := userClient.OAuthCallback(ctx.Request.Context(), &user.OAuthCallbackRequest{
+				Provider: ctx.Param("provider"), Code: ctx.Query("code"), State: state,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			if !resp.Linked && resp.ReauthToken == "" {
+				mergeGuestCart(ctx, resp.UserId)
+			}
+			response.Success(ctx, resp)
+		})
+
 		// 商城前端商品展示与搜索
-		v1.GET("/product/list", func(ctx *gin.Context) {
+		// 已登录时记录搜索历史
+		v1.GET("/product/list", middleware.OptionalAuthMiddleware(), func(ctx *gin.Context) {
 			page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 			pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
 			catId, _ := strconv.ParseInt(ctx.DefaultQuery("category_id", "0"), 10, 64)
 			query := ctx.Query("query")
-			resp, err := productClient.ListProducts(ctx.Request.Context(), &product.ListProductsRequest{
+			// 价格单位为分；sort: price_asc / price_desc / sales / rating
+			minPrice, _ := strconv.ParseInt(ctx.DefaultQuery("min_price", "0"), 10, 64)
+			maxPrice, _ := strconv.ParseInt(ctx.DefaultQuery("max_price", "0"), 10, 64)
+			inStock, _ := strconv.ParseBool(ctx.DefaultQuery("in_stock", "false"))
+			withFacets, _ := strconv.ParseBool(ctx.DefaultQuery("facets", "false"))
+			req := &product.ListProductsRequest{
 				Page: int32(page), PageSize: int32(pageSize), CategoryId: catId, Query: query,
-			})
+				MinPrice: minPrice, MaxPrice: maxPrice, InStock: inStock, Sort: ctx.Query("sort"), WithFacets: withFacets,
+				// 高亮片段在 name_highlight / description_highlight 中返回，标记可由前端指定
+				HighlightPreTag: ctx.Query("highlight_pre"), HighlightPostTag: ctx.Query("highlight_post"),
+			}
+			if v, ok := ctx.Get("userId"); ok {
+				req.UserId = v.(int64)
+			}
+			resp, err := productClient.ListProducts(ctx.Request.Context(), req)
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
 		})
 
-		// 商品详情
+		// 搜索框联想：匹配的商品名、热门搜索，已登录时附带匹配的搜索历史
+		v1.GET("/product/suggest", middleware.OptionalAuthMiddleware(), func(ctx *gin.Context) {
+			size, _ := strconv.Atoi(ctx.DefaultQuery("size", "10"))
+			req := &product.SuggestProductsRequest{Prefix: ctx.Query("prefix"), Size: int32(size)}
+			if v, ok := ctx.Get("userId"); ok {
+				req.UserId = v.(int64)
+			}
+			resp, err := productClient.SuggestProducts(ctx.Request.Context(), req)
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 分类树 (导航)，root_id 为空时返回整棵树
+		v1.GET("/category/list", func(ctx *gin.Context) {
+			rootId, _ := strconv.ParseInt(ctx.DefaultQuery("root_id", "0"), 10, 64)
+			resp, err := productClient.ListCategories(ctx.Request.Context(), &product.ListCategoriesRequest{RootId: rootId})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 商品详情：id 为商品 ID，返回全部 SKU 与规格矩阵
 		v1.GET("/product/detail", func(ctx *gin.Context) {
 			id, _ := strconv.ParseInt(ctx.Query("id"), 10, 64)
-			resp, err := productClient.GetProduct(ctx.Request.Context(), &product.GetProductRequest{Id: id})
+			resp, err := productClient.GetProductDetail(ctx.Request.Context(), &product.GetProductRequest{Id: id})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
+				return
+			}
+			// 草稿与已删除的商品前台不可见；已下架的仍返回 (status 为 off_shelf)，由前端置灰购买按钮
+			if st := resp.Product.Status; st == "draft" || st == "deleted" {
+				response.Error(ctx, http.StatusNotFound, "商品不存在")
 				return
 			}
 			response.Success(ctx, resp)
@@ -221,6 +395,138 @@ func main() {
 			}
 			response.Success(c, resp)
 		})
+
+		// 可领取的优惠券
+		v1.GET("/promotion/coupons", func(ctx *gin.Context) {
+			page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+			pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "20"))
+			resp, err := promotionClient.ListCoupons(ctx.Request.Context(), &promotion.ListCouponsRequest{
+				ClaimableOnly: true, Page: int32(page), PageSize: int32(pageSize),
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 行政区划级联查询 (parent_code 为空时返回省份)
+		v1.GET("/address/regions", func(ctx *gin.Context) {
+			resp, err := addressClient.ListRegions(ctx.Request.Context(), &address.ListRegionsRequest{ParentCode: ctx.Query("parent_code")})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+	}
+
+	// ---------------------------
+	// 购物车 (登录用户与游客均可使用)
+	// ---------------------------
+	// 未登录时使用网关签发的游客购物车 ID (带签名的 Cookie)，登录成功后合并到用户购物车
+	cartGroup := v1.Group("")
+	cartGroup.Use(middleware.OptionalAuthMiddleware(), middleware.GuestCartMiddleware(c.Cart.GuestSecret, guestCartMaxAge))
+	{
+		cartGroup.POST("/cart/add", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			var req struct {
+				SkuId    int64 `json:"sku_id" binding:"required"`
+				Quantity int32 `json:"quantity" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			_, err := cartClient.AddItem(ctx.Request.Context(), &cart.AddItemRequest{UserId: userId, GuestId: guestId, Item: &cart.CartItem{SkuId: req.SkuId, Quantity: req.Quantity}})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, nil)
+		})
+
+		// 修改购物车商品数量 (quantity 为 0 时移除)
+		cartGroup.POST("/cart/update", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			var req struct {
+				SkuId    int64 `json:"sku_id" binding:"required"`
+				Quantity int32 `json:"quantity"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := cartClient.UpdateItemQuantity(ctx.Request.Context(), &cart.UpdateItemQuantityRequest{
+				UserId: userId, GuestId: guestId, SkuId: req.SkuId, Quantity: req.Quantity,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		cartGroup.GET("/cart/list", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			resp, err := cartClient.GetCart(ctx.Request.Context(), &cart.GetCartRequest{UserId: userId, GuestId: guestId})
+			if err != nil {
+				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 购物车详情 (含价格、库存、失效标记与勾选小计)
+		cartGroup.GET("/cart/detail", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			resp, err := cartClient.GetCartDetail(ctx.Request.Context(), &cart.GetCartDetailRequest{UserId: userId, GuestId: guestId})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 勾选 / 取消勾选 (all 为 true 时全选或全不选)
+		cartGroup.POST("/cart/select", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			var req struct {
+				SkuIds   []int64 `json:"sku_ids"`
+				Selected bool    `json:"selected"`
+				All      bool    `json:"all"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := cartClient.SelectItems(ctx.Request.Context(), &cart.SelectItemsRequest{
+				UserId: userId, GuestId: guestId, SkuIds: req.SkuIds, Selected: req.Selected, All: req.All,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 删除购物车商品
+		cartGroup.POST("/cart/delete", func(ctx *gin.Context) {
+			userId, guestId := cartOwner(ctx)
+			var req struct {
+				SkuId int64 `json:"sku_id" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			_, err := cartClient.DeleteItem(ctx.Request.Context(), &cart.DeleteItemRequest{UserId: userId, GuestId: guestId, SkuId: req.SkuId})
+			if err != nil {
+				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				return
+			}
+			response.Success(ctx, nil)
+		})
 	}
 
 	// ---------------------------
@@ -272,7 +578,94 @@ func main() {
 				UserId: ctx.MustGet("userId").(int64), OldPassword: req.OldPassword, NewPassword: req.NewPassword,
 			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 申请导出个人数据
+		authed.POST("/user/export", func(ctx *gin.Context) {
+			resp, err := userClient.RequestDataExport(ctx.Request.Context(), &user.RequestDataExportRequest{UserId: ctx.MustGet("userId").(int64)})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 查询导出任务状态
+		authed.GET("/user/export/:id", func(ctx *gin.Context) {
+			id, _ := strconv.ParseInt(ctx.Param("id"), 10, 64)
+			resp, err := userClient.GetDataExport(ctx.Request.Context(), &user.GetDataExportRequest{UserId: ctx.MustGet("userId").(int64), ExportId: id})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			resp.Content = nil // 状态查询不返回文件内容
+			response.Success(ctx, resp)
+		})
+
+		// 下载导出的 JSON 归档
+		authed.GET("/user/export/:id/download", func(ctx *gin.Context) {
+			id, _ := strconv.ParseInt(ctx.Param("id"), 10, 64)
+			resp, err := userClient.GetDataExport(ctx.Request.Context(), &user.GetDataExportRequest{UserId: ctx.MustGet("userId").(int64), ExportId: id})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			if resp.Status != "ready" {
+				response.Error(ctx, http.StatusConflict, "导出文件尚未生成完成")
+				return
+			}
+			ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, resp.FileName))
+			ctx.Data(http.StatusOK, "application/json; charset=utf-8", resp.Content)
+		})
+
+		// 注销账号
+		authed.POST("/user/delete", func(ctx *gin.Context) {
+			var req struct {
+				Password    string `json:"password"`
+				ReauthToken string `json:"reauth_token"` // 无密码用户通过 /user/oauth/:provider/start?reauth=1 获取
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := userClient.DeleteAccount(ctx.Request.Context(), &user.DeleteAccountRequest{
+				UserId: ctx.MustGet("userId").(int64), Password: req.Password, ReauthToken: req.ReauthToken,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 已绑定的第三方登录方式
+		authed.GET("/user/oauth/links", func(ctx *gin.Context) {
+			resp, err := userClient.ListIdentityLinks(ctx.Request.Context(), &user.ListIdentityLinksRequest{UserId: ctx.MustGet("userId").(int64)})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 解绑第三方登录方式
+		authed.POST("/user/oauth/unlink", func(ctx *gin.Context) {
+			var req struct {
+				Provider string `json:"provider" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := userClient.UnlinkIdentity(ctx.Request.Context(), &user.UnlinkIdentityRequest{
+				UserId: ctx.MustGet("userId").(int64), Provider: req.Provider,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
@@ -288,7 +681,23 @@ func main() {
 			req.UserId = ctx.MustGet("userId").(int64)
 			resp, err := addressClient.CreateAddress(ctx.Request.Context(), &req)
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 修改地址 (省市区与手机号在 Address Service 校验)
+		authed.POST("/address/update", func(ctx *gin.Context) {
+			var req address.UpdateAddressRequest
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			req.UserId = ctx.MustGet("userId").(int64)
+			resp, err := addressClient.UpdateAddress(ctx.Request.Context(), &req)
+			if err != nil {
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
@@ -336,72 +745,192 @@ func main() {
 			})
 
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
 		})
 
-		// --- 购物车 ---
-		authed.POST("/cart/add", func(ctx *gin.Context) {
+		// --- 搜索历史 ---
+		authed.GET("/search/history", func(ctx *gin.Context) {
+			userId := ctx.MustGet("userId").(int64)
+			resp, err := productClient.ListSearchHistory(ctx.Request.Context(), &product.SearchHistoryRequest{UserId: userId})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		authed.POST("/search/history/clear", func(ctx *gin.Context) {
+			userId := ctx.MustGet("userId").(int64)
+			resp, err := productClient.ClearSearchHistory(ctx.Request.Context(), &product.SearchHistoryRequest{UserId: userId})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// --- 优惠券 ---
+		authed.POST("/promotion/coupon/claim", func(ctx *gin.Context) {
 			var req struct {
-				SkuId    int64 `json:"sku_id" binding:"required"`
-				Quantity int32 `json:"quantity" binding:"required"`
+				CouponId int64 `json:"coupon_id" binding:"required"`
 			}
 			if err := ctx.ShouldBindJSON(&req); err != nil {
-				response.Error(ctx, http.StatusBadRequest, err.Error())
+				response.Error(ctx, http.StatusBadRequest, "参数错误")
 				return
 			}
-			_, err := cartClient.AddItem(ctx.Request.Context(), &cart.AddItemRequest{UserId: ctx.MustGet("userId").(int64), Item: &cart.CartItem{SkuId: req.SkuId, Quantity: req.Quantity}})
+			resp, err := promotionClient.ClaimCoupon(ctx.Request.Context(), &promotion.ClaimCouponRequest{
+				UserId: ctx.MustGet("userId").(int64), CouponId: req.CouponId,
+			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
 				return
 			}
-			response.Success(ctx, nil)
+			response.Success(ctx, resp)
 		})
 
-		authed.GET("/cart/list", func(ctx *gin.Context) {
-			resp, err := cartClient.GetCart(ctx.Request.Context(), &cart.GetCartRequest{UserId: ctx.MustGet("userId").(int64)})
+		// 我的优惠券 (status: 0 未使用 1 已锁定 2 已使用 3 已过期，不传返回全部)
+		authed.GET("/promotion/my_coupons", func(ctx *gin.Context) {
+			st, _ := strconv.Atoi(ctx.DefaultQuery("status", "-1"))
+			resp, err := promotionClient.ListUserCoupons(ctx.Request.Context(), &promotion.ListUserCouponsRequest{
+				UserId: ctx.MustGet("userId").(int64), Status: int32(st),
+			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
 		})
 
-		// 删除购物车商品
-		authed.POST("/cart/delete", func(ctx *gin.Context) {
+		// --- 交易子组 (秒杀、下单、支付) ---
+		// 结算：冻结购物车中要下单的商品 (sku_ids 为空时取已勾选的商品)，返回 checkout_id 供下单使用
+		authed.POST("/cart/checkout", func(ctx *gin.Context) {
 			var req struct {
-				SkuId int64 `json:"sku_id" binding:"required"`
+				SkuIds []int64 `json:"sku_ids"`
 			}
 			if err := ctx.ShouldBindJSON(&req); err != nil {
 				response.Error(ctx, http.StatusBadRequest, err.Error())
 				return
 			}
-			userId := ctx.MustGet("userId").(int64)
-			_, err := cartClient.DeleteItem(ctx.Request.Context(), &cart.DeleteItemRequest{UserId: userId, SkuId: req.SkuId})
+			resp, err := cartClient.CreateCheckout(ctx.Request.Context(), &cart.CreateCheckoutRequest{
+				UserId: ctx.MustGet("userId").(int64), SkuIds: req.SkuIds,
+			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
 				return
 			}
-			response.Success(ctx, nil)
+			response.Success(ctx, resp)
 		})
 
-		// --- 交易子组 (秒杀、下单、支付) ---
+		// 订单确认页：只计价不下单，返回应付金额与价格令牌 (lines 为立即购买的商品)
+		authed.POST("/order/preview", func(ctx *gin.Context) {
+			var req struct {
+				AddressId  int64   `json:"address_id" binding:"required"`
+				SkuIds     []int64 `json:"sku_ids"`
+				CheckoutId string  `json:"checkout_id"`
+				CouponId   int64   `json:"coupon_id"`
+				Lines      []struct {
+					SkuId    int64 `json:"sku_id"`
+					Quantity int32 `json:"quantity"`
+				} `json:"lines"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			previewReq := &order.CreateOrderRequest{
+				UserId: ctx.MustGet("userId").(int64), AddressId: req.AddressId, SkuIds: req.SkuIds, CheckoutId: req.CheckoutId,
+				CouponId: req.CouponId,
+			}
+			for _, l := range req.Lines {
+				previewReq.Lines = append(previewReq.Lines, &order.OrderLine{SkuId: l.SkuId, Quantity: l.Quantity})
+			}
+			resp, err := orderClient.PreviewOrder(ctx.Request.Context(), previewReq)
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 下单：优先使用结算快照 checkout_id，兼容直接传 sku_ids
+		// price_token 必填 (来自 /order/preview)，应付金额必须与确认页一致
 		authed.POST("/order/create", func(ctx *gin.Context) {
 			var req struct {
-				AddressId int64   `json:"address_id" binding:"required"`
-				SkuIds    []int64 `json:"sku_ids" binding:"required"`
+				AddressId  int64   `json:"address_id" binding:"required"`
+				SkuIds     []int64 `json:"sku_ids"`
+				CheckoutId string  `json:"checkout_id"`
+				PriceToken string  `json:"price_token" binding:"required"`
+				CouponId   int64   `json:"coupon_id"`
 			}
 			if err := ctx.ShouldBindJSON(&req); err != nil {
-				response.Error(ctx, http.StatusBadRequest, "必须选择收货地址 (address_id)"+err.Error())
+				response.Error(ctx, http.StatusBadRequest, "必须选择收货地址 (address_id) 并确认订单 (price_token): "+err.Error())
+				return
+			}
+			if req.CheckoutId == "" && len(req.SkuIds) == 0 {
+				response.Error(ctx, http.StatusBadRequest, "未选择任何商品")
 				return
 			}
 			resp, err := orderClient.CreateOrder(ctx.Request.Context(), &order.CreateOrderRequest{
-				UserId: ctx.MustGet("userId").(int64), AddressId: req.AddressId, SkuIds: req.SkuIds,
+				UserId: ctx.MustGet("userId").(int64), AddressId: req.AddressId, SkuIds: req.SkuIds, CheckoutId: req.CheckoutId,
+				PriceToken: req.PriceToken, CouponId: req.CouponId,
 			})
 			if err != nil {
-				response.Error(ctx, http.StatusInternalServerError, err.Error())
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 立即购买：不经过购物车直接下单
+		authed.POST("/order/buy_now", func(ctx *gin.Context) {
+			var req struct {
+				AddressId  int64  `json:"address_id" binding:"required"`
+				SkuId      int64  `json:"sku_id" binding:"required"`
+				Quantity   int32  `json:"quantity" binding:"required"`
+				PriceToken string `json:"price_token" binding:"required"`
+				CouponId   int64  `json:"coupon_id"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			resp, err := orderClient.CreateOrder(ctx.Request.Context(), &order.CreateOrderRequest{
+				UserId:     ctx.MustGet("userId").(int64),
+				AddressId:  req.AddressId,
+				Lines:      []*order.OrderLine{{SkuId: req.SkuId, Quantity: req.Quantity}},
+				PriceToken: req.PriceToken,
+				CouponId:   req.CouponId,
+			})
+			if err != nil {
+				response.GRPCError(ctx, err)
+				return
+			}
+			response.Success(ctx, resp)
+		})
+
+		// 运费试算 (购物车 / 结算页)
+		authed.POST("/order/shipping/quote", func(ctx *gin.Context) {
+			var req struct {
+				AddressId int64 `json:"address_id" binding:"required"`
+				Items     []struct {
+					SkuId    int64 `json:"sku_id"`
+					Quantity int32 `json:"quantity"`
+				} `json:"items" binding:"required"`
+			}
+			if err := ctx.ShouldBindJSON(&req); err != nil {
+				response.Error(ctx, http.StatusBadRequest, err.Error())
+				return
+			}
+			quoteReq := &order.QuoteShippingRequest{UserId: ctx.MustGet("userId").(int64), AddressId: req.AddressId}
+			for _, item := range req.Items {
+				quoteReq.Items = append(quoteReq.Items, &order.ShippingItem{SkuId: item.SkuId, Quantity: item.Quantity})
+			}
+			resp, err := orderClient.QuoteShipping(ctx.Request.Context(), quoteReq)
+			if err != nil {
+				response.GRPCError(ctx, err)
 				return
 			}
 			response.Success(ctx, resp)
@@ -461,8 +990,8 @@ func main() {
 		// --- 支付接口 ---
 		authed.POST("/payment/pay", func(ctx *gin.Context) {
 			var req struct {
-				OrderNo string  `json:"order_no" binding:"required"`
-				Amount  float32 `json:"amount"`
+				OrderNo string `json:"order_no" binding:"required"`
+				Amount  int64  `json:"amount"` // 分
 			}
 			if err := ctx.ShouldBindJSON(&req); err != nil {
 				response.Error(ctx, http.StatusBadRequest, err.Error())
@@ -499,9 +1028,9 @@ func main() {
 				}
 
 				// 拿着 SkuId 去商品服务反查真实的 ProductId
-				productResp, err := productClient.GetProduct(c.Request.Context(), &product.GetProductRequest{Id: req.SkuId})
-				if err == nil && productResp != nil {
-					req.ProductId = productResp.Id // 获取真正的 product_id
+				skuResp, err := productClient.GetSku(c.Request.Context(), &product.GetSkuRequest{SkuId: req.SkuId})
+				if err == nil && skuResp != nil {
+					req.ProductId = skuResp.ProductId // 获取真正的 product_id
 				} else {
 					req.ProductId = req.SkuId // 查不到就降级
 				}
@@ -519,7 +1048,7 @@ func main() {
 					SkuName:      req.SkuName,
 				})
 				if err != nil {
-					response.Error(c, http.StatusInternalServerError, status.Convert(err).Message())
+					response.GRPCError(c, err)
 					return
 				}
 				response.Success(c, resp)
@@ -597,7 +1126,7 @@ func main() {
 				id, _ := strconv.ParseInt(ctx.Param("id"), 10, 64)
 				resp, err := adminClient.DeleteUser(ctx.Request.Context(), &admin.DeleteUserRequest{UserId: id})
 				if err != nil {
-					response.Error(ctx, http.StatusInternalServerError, err.Error())
+					response.GRPCError(ctx, err)
 					return
 				}
 				response.Success(ctx, resp)
@@ -607,9 +1136,9 @@ func main() {
 			adminGroup.GET("/products", func(ctx *gin.Context) {
 				page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 				pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "100"))
-				category := ctx.Query("category") // 从 URL 查询参数获取分类名
+				catId, _ := strconv.ParseInt(ctx.DefaultQuery("category_id", "0"), 10, 64) // 包含子分类
 				resp, err := adminClient.ListAllProducts(ctx.Request.Context(), &admin.ListAllProductsRequest{
-					Page: int32(page), PageSize: int32(pageSize), Category: category,
+					Page: int32(page), PageSize: int32(pageSize), CategoryId: catId, Status: ctx.Query("status"),
 				})
 				if err != nil {
 					response.Error(ctx, http.StatusInternalServerError, err.Error())
@@ -618,22 +1147,123 @@ func main() {
 				response.Success(ctx, resp)
 			})
 
-			// 单个商品更新 (调价/修改库存)
+			// 新建商品 (至少包含一个规格)
+			adminGroup.POST("/product/create", func(ctx *gin.Context) {
+				var req admin.CreateProductRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.CreateProduct(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 单个商品更新 (调价/修改信息；单规格商品可直接改库存)
 			adminGroup.POST("/product/update", func(ctx *gin.Context) {
 				var req admin.UpdateProductRequest
 				if err := ctx.ShouldBindJSON(&req); err != nil {
 					response.Error(ctx, http.StatusBadRequest, "参数错误")
 					return
 				}
-				resp, err := adminClient.UpdateProduct(ctx, &req)
+				resp, err := adminClient.UpdateProduct(ctx.Request.Context(), &req)
 				if err != nil {
-					response.Error(ctx, http.StatusInternalServerError, err.Error())
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 商品的规格列表
+			adminGroup.GET("/product/skus", func(ctx *gin.Context) {
+				productId, _ := strconv.ParseInt(ctx.Query("product_id"), 10, 64)
+				resp, err := adminClient.ListSkus(ctx.Request.Context(), &admin.ListSkusRequest{ProductId: productId})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 商品上下架 / 定时上下架
+			adminGroup.POST("/product/status", func(ctx *gin.Context) {
+				var req admin.SetProductStatusRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SetProductStatus(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 单个规格上下架
+			adminGroup.POST("/sku/status", func(ctx *gin.Context) {
+				var req admin.SetSkuStatusRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SetSkuStatus(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
 					return
 				}
 				response.Success(ctx, resp)
 			})
 
-			// 下架/彻底删除商品
+			// 设置商品的规格维度 (整体覆盖)
+			adminGroup.POST("/product/specs", func(ctx *gin.Context) {
+				var req admin.SetProductSpecsRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SetProductSpecs(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 新增 / 修改规格 (id 为 0 时新增，修改时不改库存)
+			adminGroup.POST("/sku/save", func(ctx *gin.Context) {
+				var req admin.SaveSkuRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SaveSku(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 设置规格库存
+			adminGroup.POST("/sku/stock", func(ctx *gin.Context) {
+				var req admin.SetSkuStockRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SetSkuStock(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 删除商品 (仅标记删除，历史订单仍可查到商品)
 			adminGroup.POST("/product/delete", func(ctx *gin.Context) {
 				var req admin.DeleteProductRequest
 				if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -642,7 +1272,7 @@ func main() {
 				}
 				resp, err := adminClient.DeleteProduct(ctx.Request.Context(), &req)
 				if err != nil {
-					response.Error(ctx, http.StatusInternalServerError, err.Error())
+					response.GRPCError(ctx, err)
 					return
 				}
 				response.Success(ctx, resp)
@@ -657,7 +1287,18 @@ func main() {
 				}
 				resp, err := adminClient.BatchUpdatePrice(ctx.Request.Context(), &req)
 				if err != nil {
-					response.Error(ctx, http.StatusInternalServerError, err.Error())
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 全量重建商品搜索索引 (后台执行并立即返回，完成后切换别名，重建期间搜索不中断)
+			// 已有重建任务时返回 409，搜索服务不可用时返回 503
+			adminGroup.POST("/search/reindex", func(ctx *gin.Context) {
+				resp, err := adminClient.ReindexSearch(ctx.Request.Context(), &admin.ReindexSearchRequest{})
+				if err != nil {
+					response.GRPCError(ctx, err)
 					return
 				}
 				response.Success(ctx, resp)
@@ -679,6 +1320,115 @@ func main() {
 				}
 				response.Success(ctx, resp)
 			})
+
+			// 分类树 (含各分类商品数)
+			adminGroup.GET("/categories", func(ctx *gin.Context) {
+				resp, err := adminClient.ListCategories(ctx.Request.Context(), &admin.ListCategoriesRequest{})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 新增 / 修改分类 (id 为 0 时新增)
+			adminGroup.POST("/category/save", func(ctx *gin.Context) {
+				var req admin.SaveCategoryRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SaveCategory(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 删除分类 (存在子分类或商品时拒绝)
+			adminGroup.POST("/category/delete", func(ctx *gin.Context) {
+				var req admin.DeleteCategoryRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.DeleteCategory(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 运费规则列表
+			adminGroup.GET("/shipping/rules", func(ctx *gin.Context) {
+				resp, err := adminClient.ListShippingRules(ctx.Request.Context(), &admin.ListShippingRulesRequest{})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 新增 / 修改运费规则 (id 为 0 时新增)
+			adminGroup.POST("/shipping/rule/save", func(ctx *gin.Context) {
+				var rule admin.ShippingRuleInfo
+				if err := ctx.ShouldBindJSON(&rule); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SaveShippingRule(ctx.Request.Context(), &admin.SaveShippingRuleRequest{Rule: &rule})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 优惠券列表 (含已停用、已过期的)
+			adminGroup.GET("/promotion/coupons", func(ctx *gin.Context) {
+				page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+				pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "20"))
+				resp, err := adminClient.ListCoupons(ctx.Request.Context(), &admin.ListCouponsRequest{
+					Page: int32(page), PageSize: int32(pageSize),
+				})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 新增 / 修改优惠券 (id 为 0 时新增)
+			adminGroup.POST("/promotion/coupon/save", func(ctx *gin.Context) {
+				var coupon admin.CouponInfo
+				if err := ctx.ShouldBindJSON(&coupon); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.SaveCoupon(ctx.Request.Context(), &admin.SaveCouponRequest{Coupon: &coupon})
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
+
+			// 删除运费规则
+			adminGroup.POST("/shipping/rule/delete", func(ctx *gin.Context) {
+				var req admin.DeleteShippingRuleRequest
+				if err := ctx.ShouldBindJSON(&req); err != nil {
+					response.Error(ctx, http.StatusBadRequest, "参数错误")
+					return
+				}
+				resp, err := adminClient.DeleteShippingRule(ctx.Request.Context(), &req)
+				if err != nil {
+					response.GRPCError(ctx, err)
+					return
+				}
+				response.Success(ctx, resp)
+			})
 		}
 	}
 
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -22,37 +23,57 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			response.Error(ctx, http.StatusUnauthorized, "Invalid authorization format")
+		userId, err := parseAuthHeader(authHeader)
+		if err != nil {
+			response.Error(ctx, http.StatusUnauthorized, err.Error())
 			ctx.Abort()
 			return
 		}
-		tokenString := parts[1]
+		ctx.Set("userId", userId)
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return jwtSecret, nil
-		})
+		ctx.Next()
+	}
+}
 
-		if err != nil || !token.Valid {
-			response.Error(ctx, http.StatusUnauthorized, "Invalid token")
-			ctx.Abort()
-			return
+// OptionalAuthMiddleware 可选鉴权：携带合法 Token 时写入 userId，否则按匿名请求放行
+// 用于登录前后行为不同的公开接口 (如第三方登录：已登录时为绑定账号)
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if authHeader := ctx.GetHeader("Authorization"); authHeader != "" {
+			if userId, err := parseAuthHeader(authHeader); err == nil {
+				ctx.Set("userId", userId)
+			}
 		}
+		ctx.Next()
+	}
+}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			if userIdFloat, ok := claims["user_id"].(float64); ok {
-				ctx.Set("userId", int64(userIdFloat))
-			} else {
-				response.Error(ctx, http.StatusUnauthorized, "Invalid token claims")
-				ctx.Abort()
-				return
-			}
+// parseAuthHeader 解析 "Bearer <token>" 并返回其中的 user_id
+func parseAuthHeader(authHeader string) (int64, error) {
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return 0, errors.New("Invalid authorization format")
+	}
+	tokenString := parts[1]
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
 		}
+		return jwtSecret, nil
+	})
 
-		ctx.Next()
+	if err != nil || !token.Valid {
+		return 0, errors.New("Invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, errors.New("Invalid token claims")
+	}
+	userIdFloat, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, errors.New("Invalid token claims")
 	}
+	return int64(userIdFloat), nil
 }
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GuestCartCookie 游客购物车 Cookie，格式为 "<guest_id>.<签名>"
+const GuestCartCookie = "guest_cart"
+
+const guestCartPath = "/api/v1"
+
+// GuestCartMiddleware 游客购物车：未登录请求校验 (或签发) 带签名的游客购物车 ID，写入 guestCartId
+// 需放在 OptionalAuthMiddleware 之后；已登录请求直接放行。maxAge 单位为秒，每次访问顺延
+func GuestCartMiddleware(secret string, maxAge int) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if _, ok := ctx.Get("userId"); ok {
+			ctx.Next()
+			return
+		}
+		guestId, ok := GuestCartId(ctx, secret)
+		if !ok {
+			b := make([]byte, 16)
+			if _, err := rand.Read(b); err != nil {
+				ctx.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			guestId = hex.EncodeToString(b)
+		}
+		ctx.SetSameSite(http.SameSiteLaxMode)
+		ctx.SetCookie(GuestCartCookie, guestId+"."+signGuestId(secret, guestId), maxAge, guestCartPath, "", false, true)
+		ctx.Set("guestCartId", guestId)
+		ctx.Next()
+	}
+}
+
+// GuestCartId 读取并校验游客购物车 Cookie，签名不符视为不存在
+func GuestCartId(ctx *gin.Context, secret string) (string, bool) {
+	v, err := ctx.Cookie(GuestCartCookie)
+	if err != nil {
+		return "", false
+	}
+	guestId, sig, ok := strings.Cut(v, ".")
+	if !ok || guestId == "" {
+		return "", false
+	}
+	if !hmac.Equal([]byte(sig), []byte(signGuestId(secret, guestId))) {
+		return "", false
+	}
+	return guestId, true
+}
+
+// ClearGuestCart 删除游客购物车 Cookie (登录合并后调用)
+func ClearGuestCart(ctx *gin.Context) {
+	ctx.SetCookie(GuestCartCookie, "", -1, guestCartPath, "", false, true)
+}
+
+func signGuestId(secret, guestId string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(guestId))
+	return hex.EncodeToString(mac.Sum(nil))
+}
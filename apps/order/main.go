@@ -3,22 +3,29 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"go-ecommerce/apps/order/model"
+	"go-ecommerce/apps/order/pricetoken"
 	"go-ecommerce/pkg/config"
 	"go-ecommerce/pkg/database"
 	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/events"
+	"go-ecommerce/pkg/money"
 	"go-ecommerce/pkg/tracer"
 	"go-ecommerce/proto/address"
 	"go-ecommerce/proto/cart"
 	"go-ecommerce/proto/order"
 	"go-ecommerce/proto/product"
+	"go-ecommerce/proto/promotion"
 
 	_ "github.com/mbobakov/grpc-consul-resolver"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -44,6 +51,11 @@ const (
 
 	// 秒杀队列配置 (用于削峰填谷)
 	SeckillQueue = "seckill.order.queue"
+
+	// 价格令牌默认有效期 (分钟)
+	defaultPriceTokenTTL = 15
+	// 立即购买单个商品默认最多件数 (与购物车一致)
+	defaultMaxQuantityPerSku = 99
 )
 
 // 秒杀消息结构体 (必须与 Product Service 发送的格式一致)
@@ -60,6 +72,57 @@ type server struct {
 	productClient product.ProductServiceClient
 	cartClient    cart.CartServiceClient
 	addressClient address.AddressServiceClient
+	promoClient   promotion.PromotionServiceClient
+
+	priceTokenSecret []byte        // 订单确认价格令牌签名密钥
+	priceTokenTTL    time.Duration // 价格令牌有效期
+
+	maxQuantityPerSku int // 单个商品最多购买件数 (与购物车共用 cart.max_quantity_per_sku)
+}
+
+// shippingLine 参与运费计算的商品行
+type shippingLine struct {
+	Price    money.Cents
+	Weight   int // 单件重量 (克)
+	Quantity int
+}
+
+// shippingQuote 运费试算结果
+type shippingQuote struct {
+	Deliverable   bool
+	GoodsAmount   money.Cents
+	Fee           money.Cents
+	FreeThreshold money.Cents
+	RuleName      string
+}
+
+// calcShipping 按收货省份匹配运费规则并计算运费
+func (s *server) calcShipping(provinceCode string, lines []shippingLine) (*shippingQuote, error) {
+	var rules []model.ShippingRule
+	if err := s.db.Where("enabled = ?", true).Order("priority DESC, id ASC").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+
+	q := &shippingQuote{Deliverable: true}
+	var count, weight int
+	for _, l := range lines {
+		q.GoodsAmount += l.Price.Mul(int64(l.Quantity))
+		count += l.Quantity
+		weight += l.Weight * l.Quantity
+	}
+
+	rule := model.MatchShippingRule(rules, provinceCode)
+	if rule == nil {
+		return q, nil // 未配置运费规则，默认包邮
+	}
+	q.RuleName = rule.Name
+	if !rule.Deliverable {
+		q.Deliverable = false
+		return q, nil
+	}
+	q.FreeThreshold = rule.FreeThreshold
+	q.Fee = rule.Fee(count, weight, q.GoodsAmount)
+	return q, nil
 }
 
 // initRabbitMQ 初始化 RabbitMQ 所有队列和交换机
@@ -126,6 +189,14 @@ func (s *server) initRabbitMQ() error {
 		return fmt.Errorf("声明秒杀队列失败: %v", err)
 	}
 
+	// -------------------------------------------------------
+	// 3. 声明订单事件交换机 (order.created 等，由订阅方自行绑定队列)
+	// -------------------------------------------------------
+	err = s.mqCh.ExchangeDeclare(events.OrderEventExchange, "topic", true, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("声明订单事件交换机失败: %v", err)
+	}
+
 	log.Println("RabbitMQ 初始化成功 (包含 DLX 和 秒杀队列)")
 	return nil
 }
@@ -144,6 +215,54 @@ func (s *server) publishDelayMessage(orderNo string) error {
 		})
 }
 
+// publishOrderCreated 发送 order.created 事件，投递成功后标记订单，避免重复补发
+func (s *server) publishOrderCreated(o *model.Order) {
+	if s.mqCh == nil || o.CheckoutID == "" {
+		return
+	}
+	ev := events.OrderCreatedEvent{OrderNo: o.OrderNo, UserID: o.UserID, CheckoutID: o.CheckoutID}
+	for _, item := range o.Items {
+		ev.Items = append(ev.Items, events.OrderCreatedItem{SkuID: item.SkuID, Quantity: item.Quantity})
+	}
+	body, _ := json.Marshal(ev)
+	err := s.mqCh.PublishWithContext(context.Background(),
+		events.OrderEventExchange,
+		events.OrderCreatedRouting,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType:  "application/json",
+			Body:         body,
+			DeliveryMode: amqp.Persistent,
+		})
+	if err != nil {
+		log.Printf("[MQ Error] 发送 order.created 事件失败: %v", err)
+		return
+	}
+	s.db.Model(&model.Order{}).Where("id = ?", o.ID).Update("cart_cleanup_sent", true)
+}
+
+// startOutboxRelay 定时补发未投递成功的 order.created 事件，保证已下单的商品最终从购物车移除
+func (s *server) startOutboxRelay() {
+	go func() {
+		ticker := time.NewTicker(30 * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			var orders []model.Order
+			err := s.db.Preload("Items").
+				Where("checkout_id <> '' AND cart_cleanup_sent = ? AND created_at < ?", false, time.Now().Add(-30*time.Second)).
+				Limit(100).Find(&orders).Error
+			if err != nil {
+				log.Printf("[MQ] 查询待补发的 order.created 事件失败: %v", err)
+				continue
+			}
+			for i := range orders {
+				s.publishOrderCreated(&orders[i])
+			}
+		}
+	}()
+}
+
 // startConsumer 启动消费者协程
 func (s *server) startConsumer() {
 	// -------------------------------------------------------
@@ -228,6 +347,7 @@ func (s *server) createSeckillOrder(userId, skuId int64) error {
 	}
 
 	var receiverName, receiverMobile, fullAddr string
+	var addressID int64
 
 	// 2. 获取用户地址 (兜底逻辑)
 	addrResp, err := s.addressClient.ListAddress(ctx, &address.ListAddressRequest{UserId: userId})
@@ -238,13 +358,14 @@ func (s *server) createSeckillOrder(userId, skuId int64) error {
 		fullAddr = "秒杀专用通道虚拟地址"
 	} else {
 		addr := addrResp.Addresses[0]
+		addressID = addr.Id
 		receiverName = addr.Name
 		receiverMobile = addr.Mobile
-		fullAddr = fmt.Sprintf("%s%s%s%s", addr.Province, addr.City, addr.District, addr.DetailAddress)
+		fullAddr = fullAddress(addr)
 	}
 
 	// 3. 获取商品信息 (为了存快照价格)
-	prodResp, err := s.productClient.GetProduct(ctx, &product.GetProductRequest{Id: skuId})
+	prodResp, err := s.productClient.GetSku(ctx, &product.GetSkuRequest{SkuId: skuId})
 	if err != nil {
 		return fmt.Errorf("查询商品失败: %v", err)
 	}
@@ -253,17 +374,20 @@ func (s *server) createSeckillOrder(userId, skuId int64) error {
 	newOrder := model.Order{
 		OrderNo:         orderNo,
 		UserID:          userId,
-		TotalAmount:     float64(prodResp.Price),
+		TotalAmount:     money.Cents(prodResp.Price),
+		GoodsAmount:     money.Cents(prodResp.Price),
+		ShippingFee:     0, // 秒杀商品包邮
 		Status:          0, // 待支付
+		AddressID:       addressID,
 		ReceiverName:    receiverName,
 		ReceiverMobile:  receiverMobile,
 		ReceiverAddress: fullAddr,
 		Items: []model.OrderItem{{
-			ProductID:   prodResp.Id,
+			ProductID:   prodResp.ProductId,
 			SkuID:       prodResp.SkuId,
 			ProductName: prodResp.Name,
 			SkuName:     prodResp.SkuName,
-			Price:       float64(prodResp.Price),
+			Price:       money.Cents(prodResp.Price),
 			Quantity:    1,
 			Picture:     prodResp.Picture,
 		}},
@@ -280,104 +404,497 @@ func (s *server) createSeckillOrder(userId, skuId int64) error {
 	return nil
 }
 
-// CreateOrder 普通下单逻辑 (保持不变)
-func (s *server) CreateOrder(ctx context.Context, req *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
+// orderLine 下单 / 预览的商品行，Sku 为 nil 表示商品不存在或已下架
+type orderLine struct {
+	SkuId    int64
+	Quantity int32
+	Sku      *product.SkuInfo
+}
+
+// pricedOrder 计价结果：商品当前价格、运费、优惠与应付金额
+type pricedOrder struct {
+	Address     *address.AddressInfo
+	Lines       []orderLine
+	Quote       *shippingQuote
+	CouponId    int64
+	Coupon      *promotion.DiscountResult // 未使用优惠券时为 nil
+	GoodsAmount money.Cents
+	Discount    money.Cents
+	PayAmount   money.Cents
+}
+
+// normalizeLines 立即购买的商品行：校验数量并合并重复的 SKU
+// 合并后的数量按 int64 累加，超过单个商品上限时拒绝，避免 int32 溢出
+func normalizeLines(in []*order.OrderLine, maxQuantity int) ([]*cart.CartItem, error) {
+	var items []*cart.CartItem
+	quantities := make(map[int64]int64, len(in))
+	for _, line := range in {
+		if line.SkuId <= 0 || line.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "商品数量必须大于 0")
+		}
+		if _, ok := quantities[line.SkuId]; !ok {
+			items = append(items, &cart.CartItem{SkuId: line.SkuId})
+		}
+		quantities[line.SkuId] += int64(line.Quantity)
+		if quantities[line.SkuId] > int64(maxQuantity) {
+			return nil, status.Errorf(codes.InvalidArgument, "单个商品最多购买 %d 件", maxQuantity)
+		}
+	}
+	for _, item := range items {
+		item.Quantity = int32(quantities[item.SkuId])
+	}
+	return items, nil
+}
+
+// validateOrderRequest 校验下单 / 预览请求的商品来源：立即购买、结算快照或购物车商品三选一
+func validateOrderRequest(req *order.CreateOrderRequest) error {
 	if req.AddressId <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "必须选择收货地址")
+		return status.Error(codes.InvalidArgument, "必须选择收货地址")
 	}
-	if len(req.SkuIds) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "未选择任何商品")
+	if len(req.Lines) > 0 && (req.CheckoutId != "" || len(req.SkuIds) > 0) {
+		return status.Error(codes.InvalidArgument, "立即购买不能同时指定购物车商品")
+	}
+	if len(req.Lines) == 0 && req.CheckoutId == "" && len(req.SkuIds) == 0 {
+		return status.Error(codes.InvalidArgument, "未选择任何商品")
 	}
+	return nil
+}
 
-	addrResp, err := s.addressClient.GetAddress(ctx, &address.GetAddressRequest{AddressId: req.AddressId})
+// priceOrder 查询收货地址、商品当前价格与库存，计算运费和应付金额
+// 不产生任何副作用，PreviewOrder 与 CreateOrder 共用，保证两者金额一致
+func (s *server) priceOrder(ctx context.Context, userId, addressId, couponId int64, items []*cart.CartItem) (*pricedOrder, error) {
+	addrResp, err := s.addressClient.GetAddress(ctx, &address.GetAddressRequest{AddressId: addressId, UserId: userId})
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "地址不存在")
 	}
-	fullAddress := fmt.Sprintf("%s%s%s%s", addrResp.Address.Province, addrResp.Address.City, addrResp.Address.District, addrResp.Address.DetailAddress)
 
-	// 利用 cartResp 校验商品是否在购物车中，解决 cartResp 未使用报错
-	cartResp, err := s.cartClient.GetCart(ctx, &cart.GetCartRequest{UserId: req.UserId})
-	if err != nil || len(cartResp.Items) == 0 {
-		return nil, status.Error(codes.Unknown, "购物车为空")
+	skuIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		skuIds = append(skuIds, item.SkuId)
+	}
+	skuResp, err := s.productClient.BatchGetSkus(ctx, &product.BatchGetSkusRequest{SkuIds: skuIds})
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "查询商品信息失败")
+	}
+	skus := make(map[int64]*product.SkuInfo, len(skuResp.Skus))
+	for _, sku := range skuResp.Skus {
+		skus[sku.SkuId] = sku
 	}
 
-	// 将购物车项存入 Map 方便比对
-	cartMap := make(map[int64]*cart.CartItem)
-	for _, item := range cartResp.Items {
-		cartMap[item.SkuId] = item
+	p := &pricedOrder{Address: addrResp.Address, CouponId: couponId}
+	var lines []shippingLine
+	for _, item := range items {
+		line := orderLine{SkuId: item.SkuId, Quantity: item.Quantity, Sku: skus[item.SkuId]}
+		if line.Sku != nil {
+			lines = append(lines, shippingLine{Price: money.Cents(line.Sku.Price), Weight: int(line.Sku.Weight), Quantity: int(item.Quantity)})
+		}
+		p.Lines = append(p.Lines, line)
 	}
 
-	tx := s.db.Begin()
-	var totalAmount float32
-	var orderItems []model.OrderItem
+	// 优惠按商品当前价格试算 (不占用优惠券)，商品失效时订单本就不可提交，不再试算
+	if couponId > 0 && len(lines) == len(p.Lines) {
+		p.Coupon, err = s.promoClient.CalculateDiscount(ctx, &promotion.CalculateDiscountRequest{
+			UserId:       userId,
+			UserCouponId: couponId,
+			Lines:        p.discountLines(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.Discount = money.Cents(p.Coupon.Amount)
+	}
 
-	for _, skuId := range req.SkuIds {
-		// 校验下单项是否存在于购物车
-		cartItem, ok := cartMap[skuId]
-		if !ok {
-			tx.Rollback()
-			return nil, status.Errorf(codes.InvalidArgument, "商品 SKU %d 不在购物车中", skuId)
+	p.Quote, err = s.calcShipping(addrResp.Address.ProvinceCode, lines)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "计算运费失败")
+	}
+	// 实付金额 = 商品金额 + 运费 - 优惠
+	p.GoodsAmount = p.Quote.GoodsAmount
+	p.PayAmount = p.GoodsAmount + p.Quote.Fee - p.Discount
+	return p, nil
+}
+
+// discountLines 参与优惠计算的商品行
+func (p *pricedOrder) discountLines() []*promotion.DiscountLine {
+	lines := make([]*promotion.DiscountLine, 0, len(p.Lines))
+	for _, l := range p.Lines {
+		lines = append(lines, &promotion.DiscountLine{SkuId: l.SkuId, CategoryId: l.Sku.CategoryId, Price: l.Sku.Price, Quantity: l.Quantity})
+	}
+	return lines
+}
+
+// discounts 优惠明细
+func (p *pricedOrder) discounts() []*order.OrderDiscountInfo {
+	if p.Coupon == nil {
+		return nil
+	}
+	return []*order.OrderDiscountInfo{{
+		CouponId:     p.Coupon.CouponId,
+		UserCouponId: p.Coupon.UserCouponId,
+		Name:         p.Coupon.Name,
+		Amount:       p.Coupon.Amount,
+	}}
+}
+
+// claims 价格令牌内容：地址、商品行、优惠券与应付金额
+func (p *pricedOrder) claims(userId, addressId int64) pricetoken.Claims {
+	lines := make([]pricetoken.Line, 0, len(p.Lines))
+	for _, l := range p.Lines {
+		lines = append(lines, pricetoken.Line{SkuID: l.SkuId, Quantity: l.Quantity})
+	}
+	return pricetoken.Claims{
+		UserID:    userId,
+		AddressID: addressId,
+		Lines:     pricetoken.FormatLines(lines),
+		CouponID:  p.CouponId,
+		PayCents:  int64(p.PayAmount),
+	}
+}
+
+// verifyPriceToken 下单时重新计价的结果必须与用户确认时的令牌一致
+func (s *server) verifyPriceToken(token string, req *order.CreateOrderRequest, p *pricedOrder) error {
+	c, err := pricetoken.Verify(s.priceTokenSecret, token, time.Now())
+	if errors.Is(err, pricetoken.ErrExpired) {
+		return status.Error(codes.FailedPrecondition, "订单确认已过期，请重新确认订单")
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "价格令牌无效")
+	}
+	want := p.claims(req.UserId, req.AddressId)
+	switch err := c.Compare(want); {
+	case errors.Is(err, pricetoken.ErrAmountChanged):
+		return status.Errorf(codes.FailedPrecondition, "订单金额已由 %s 元变为 %s 元，请重新确认订单",
+			money.Cents(c.PayCents), money.Cents(want.PayCents))
+	case err != nil:
+		return status.Error(codes.FailedPrecondition, "订单内容已变化，请重新确认订单")
+	}
+	return nil
+}
+
+// PreviewOrder 订单确认页：与 CreateOrder 输入相同，只计价不锁库存、不占用结算快照
+func (s *server) PreviewOrder(ctx context.Context, req *order.CreateOrderRequest) (*order.PreviewOrderResponse, error) {
+	if err := validateOrderRequest(req); err != nil {
+		return nil, err
+	}
+
+	var items []*cart.CartItem
+	switch {
+	case len(req.Lines) > 0:
+		lines, err := normalizeLines(req.Lines, s.maxQuantityPerSku)
+		if err != nil {
+			return nil, err
 		}
+		items = lines
+	case req.CheckoutId != "":
+		checkout, err := s.cartClient.GetCheckout(ctx, &cart.GetCheckoutRequest{UserId: req.UserId, CheckoutId: req.CheckoutId})
+		if err != nil {
+			return nil, err
+		}
+		items = checkout.Items
+	default:
+		cartResp, err := s.cartClient.GetCart(ctx, &cart.GetCartRequest{UserId: req.UserId})
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "查询购物车失败")
+		}
+		inCart := make(map[int64]*cart.CartItem, len(cartResp.Items))
+		for _, item := range cartResp.Items {
+			inCart[item.SkuId] = item
+		}
+		seen := make(map[int64]bool, len(req.SkuIds))
+		for _, skuId := range req.SkuIds {
+			item, ok := inCart[skuId]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "商品 SKU %d 不在购物车中", skuId)
+			}
+			if !seen[skuId] {
+				seen[skuId] = true
+				items = append(items, item)
+			}
+		}
+	}
 
-		prodResp, err := s.productClient.GetProduct(ctx, &product.GetProductRequest{Id: skuId})
+	p, err := s.priceOrder(ctx, req.UserId, req.AddressId, req.CouponId, items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &order.PreviewOrderResponse{
+		GoodsAmount:    int64(p.GoodsAmount),
+		ShippingFee:    int64(p.Quote.Fee),
+		DiscountAmount: int64(p.Discount),
+		PayAmount:      int64(p.PayAmount),
+		Deliverable:    p.Quote.Deliverable,
+		Available:      p.Quote.Deliverable,
+		Discounts:      p.discounts(),
+	}
+	if !p.Quote.Deliverable {
+		resp.Message = fmt.Sprintf("%s%s 暂不支持配送", p.Address.Province, p.Address.City)
+	}
+	for _, l := range p.Lines {
+		line := &order.PreviewLine{SkuId: l.SkuId, Quantity: l.Quantity}
+		switch {
+		case l.Sku == nil:
+			if resp.Available {
+				resp.Message = fmt.Sprintf("商品 SKU %d 已失效", l.SkuId)
+			}
+			resp.Available = false
+		default:
+			line.ProductId = l.Sku.ProductId
+			line.Name = l.Sku.Name
+			line.SkuName = l.Sku.SkuName
+			line.Picture = l.Sku.Picture
+			line.Price = l.Sku.Price
+			line.Stock = l.Sku.Stock
+			line.Subtotal = int64(money.Cents(l.Sku.Price).Mul(int64(l.Quantity)))
+			line.Available = l.Sku.Stock >= l.Quantity
+			if !line.Available {
+				if resp.Available {
+					resp.Message = fmt.Sprintf("商品 %s 库存不足", l.Sku.Name)
+				}
+				resp.Available = false
+			}
+		}
+		resp.Lines = append(resp.Lines, line)
+	}
+
+	if resp.Available {
+		c := p.claims(req.UserId, req.AddressId)
+		c.ExpiresAt = time.Now().Add(s.priceTokenTTL).Unix()
+		resp.PriceToken = pricetoken.Sign(s.priceTokenSecret, c)
+		resp.TokenExpiresAt = c.ExpiresAt
+	}
+	return resp, nil
+}
+
+// CreateOrder 普通下单逻辑
+func (s *server) CreateOrder(ctx context.Context, req *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
+	if err := validateOrderRequest(req); err != nil {
+		return nil, err
+	}
+	if req.PriceToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少价格令牌，请先确认订单")
+	}
+
+	// 下单商品与数量：立即购买直接使用请求中的商品；否则以购物车结算快照为准
+	// 未提供 checkout_id 时按 sku_ids 即时创建快照
+	var items []*cart.CartItem
+	var checkoutId string
+	committed := false
+	if len(req.Lines) > 0 {
+		lines, err := normalizeLines(req.Lines, s.maxQuantityPerSku)
 		if err != nil {
-			tx.Rollback()
-			return nil, status.Errorf(codes.NotFound, "商品 SKU %d 不存在", skuId)
+			return nil, err
 		}
+		items = lines
+	} else {
+		checkoutId = req.CheckoutId
+		if checkoutId == "" {
+			co, err := s.cartClient.CreateCheckout(ctx, &cart.CreateCheckoutRequest{UserId: req.UserId, SkuIds: req.SkuIds})
+			if err != nil {
+				return nil, err
+			}
+			checkoutId = co.CheckoutId
+		}
+		checkout, err := s.cartClient.LockCheckout(ctx, &cart.LockCheckoutRequest{UserId: req.UserId, CheckoutId: checkoutId})
+		if err != nil {
+			return nil, err
+		}
+		items = checkout.Items
+		// 下单失败时释放快照，允许用户重新提交
+		defer func() {
+			if !committed {
+				_, _ = s.cartClient.UnlockCheckout(context.Background(), &cart.UnlockCheckoutRequest{UserId: req.UserId, CheckoutId: checkoutId})
+			}
+		}()
+	}
+
+	// 先校验商品并计算运费，不可配送时不扣减库存
+	p, err := s.priceOrder(ctx, req.UserId, req.AddressId, req.CouponId, items)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range p.Lines {
+		if l.Sku == nil {
+			return nil, status.Errorf(codes.NotFound, "商品 SKU %d 不存在或已下架", l.SkuId)
+		}
+	}
+	if !p.Quote.Deliverable {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s%s 暂不支持配送", p.Address.Province, p.Address.City)
+	}
+	if err := s.verifyPriceToken(req.PriceToken, req, p); err != nil {
+		return nil, err
+	}
 
-		_, err = s.productClient.DecreaseStock(ctx, &product.DecreaseStockRequest{SkuId: skuId, Count: cartItem.Quantity})
+	// 订单号提前生成，用于锁定优惠券；锁定结果必须与计价时一致
+	orderNo := fmt.Sprintf("%d%d", time.Now().UnixNano(), req.UserId)
+	lineDiscounts := make(map[int64]money.Cents)
+	var discounts []model.OrderDiscount
+	if p.Coupon != nil {
+		locked, err := s.promoClient.LockCoupon(ctx, &promotion.LockCouponRequest{
+			UserId:       req.UserId,
+			UserCouponId: req.CouponId,
+			OrderNo:      orderNo,
+			Lines:        p.discountLines(),
+		})
 		if err != nil {
-			tx.Rollback()
-			return nil, status.Errorf(codes.ResourceExhausted, "商品 %s 库存不足", prodResp.Name)
+			return nil, err
+		}
+		defer func() {
+			if !committed {
+				_, _ = s.promoClient.ReleaseCoupon(context.Background(), &promotion.OrderCouponRequest{OrderNo: orderNo})
+			}
+		}()
+		if money.Cents(locked.Amount) != p.Discount {
+			return nil, status.Error(codes.FailedPrecondition, "优惠金额已变化，请重新确认订单")
+		}
+		for _, l := range locked.Lines {
+			lineDiscounts[l.SkuId] += money.Cents(l.Amount)
 		}
+		discounts = append(discounts, model.OrderDiscount{
+			OrderNo:      orderNo,
+			CouponID:     locked.CouponId,
+			UserCouponID: locked.UserCouponId,
+			Name:         locked.Name,
+			Amount:       money.Cents(locked.Amount),
+		})
+	}
+
+	tx := s.db.Begin()
+	var orderItems []model.OrderItem
+
+	// 下单失败时回补已扣减的库存
+	var decreased []orderLine
+	defer func() {
+		if committed {
+			return
+		}
+		for _, l := range decreased {
+			if _, err := s.productClient.RollbackStock(context.Background(), &product.RollbackStockRequest{SkuId: l.SkuId, Count: l.Quantity}); err != nil {
+				log.Printf("[严重错误] 订单 %s 回滚库存失败: %v", orderNo, err)
+			}
+		}
+	}()
 
-		totalAmount += prodResp.Price * float32(cartItem.Quantity)
+	for _, l := range p.Lines {
+		_, err = s.productClient.DecreaseStock(ctx, &product.DecreaseStockRequest{SkuId: l.SkuId, Count: l.Quantity})
+		if err != nil {
+			tx.Rollback()
+			if status.Code(err) == codes.FailedPrecondition {
+				return nil, status.Errorf(codes.FailedPrecondition, "商品 %s 库存不足", l.Sku.Name)
+			}
+			return nil, err
+		}
+		decreased = append(decreased, l)
 
 		orderItems = append(orderItems, model.OrderItem{
-			ProductID:   prodResp.Id,
-			SkuID:       prodResp.SkuId,
-			ProductName: prodResp.Name,
-			SkuName:     prodResp.SkuName,
-			Price:       float64(prodResp.Price),
-			Quantity:    int(cartItem.Quantity),
-			Picture:     prodResp.Picture,
+			ProductID:   l.Sku.ProductId,
+			SkuID:       l.SkuId,
+			ProductName: l.Sku.Name,
+			SkuName:     l.Sku.SkuName,
+			Price:       money.Cents(l.Sku.Price),
+			Quantity:    int(l.Quantity),
+			Picture:     l.Sku.Picture,
+
+			DiscountAmount: lineDiscounts[l.SkuId],
 		})
 	}
 
-	orderNo := fmt.Sprintf("%d%d", time.Now().UnixNano(), req.UserId)
+	addr := p.Address
 	newOrder := model.Order{
 		OrderNo:         orderNo,
 		UserID:          req.UserId,
-		TotalAmount:     float64(totalAmount),
+		TotalAmount:     p.PayAmount,
+		GoodsAmount:     p.GoodsAmount,
+		ShippingFee:     p.Quote.Fee,
+		DiscountAmount:  p.Discount,
 		Status:          0,
 		Items:           orderItems,
-		ReceiverName:    addrResp.Address.Name,
-		ReceiverMobile:  addrResp.Address.Mobile,
-		ReceiverAddress: fullAddress,
+		Discounts:       discounts,
+		AddressID:       req.AddressId,
+		ReceiverName:    addr.Name,
+		ReceiverMobile:  addr.Mobile,
+		ReceiverAddress: fullAddress(addr),
+		CheckoutID:      checkoutId,
 	}
 
 	if err := tx.Create(&newOrder).Error; err != nil {
 		tx.Rollback()
 		return nil, status.Error(codes.Internal, "创建订单失败")
 	}
-	tx.Commit()
-
-	for _, skuId := range req.SkuIds {
-		_, _ = s.cartClient.DeleteItem(ctx, &cart.DeleteItemRequest{
-			UserId: req.UserId,
-			SkuId:  skuId,
-		})
+	if err := tx.Commit().Error; err != nil {
+		return nil, status.Error(codes.Internal, "创建订单失败")
 	}
+	committed = true
 
+	// 购物车清理由 Cart Service 消费 order.created 完成；投递失败时由 startOutboxRelay 补发
+	s.publishOrderCreated(&newOrder)
 	_ = s.publishDelayMessage(orderNo)
 
-	return &order.CreateOrderResponse{OrderNo: orderNo, TotalAmount: totalAmount}, nil
+	return &order.CreateOrderResponse{OrderNo: orderNo, TotalAmount: int64(p.PayAmount), ShippingFee: int64(p.Quote.Fee)}, nil
+}
+
+// fullAddress 拼接收货地址；不设区县的城市区县与城市相同，只保留一次
+func fullAddress(addr *address.AddressInfo) string {
+	district := addr.District
+	if district == addr.City {
+		district = ""
+	}
+	return addr.Province + addr.City + district + addr.DetailAddress
+}
+
+// QuoteShipping 运费试算：按收货地址与商品件数/重量计算运费，供购物车与结算页展示
+func (s *server) QuoteShipping(ctx context.Context, req *order.QuoteShippingRequest) (*order.QuoteShippingResponse, error) {
+	if req.AddressId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "必须选择收货地址")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "未选择任何商品")
+	}
+
+	addrResp, err := s.addressClient.GetAddress(ctx, &address.GetAddressRequest{AddressId: req.AddressId, UserId: req.UserId})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "地址不存在")
+	}
+
+	var lines []shippingLine
+	for _, item := range req.Items {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "商品 SKU %d 数量必须大于 0", item.SkuId)
+		}
+		prodResp, err := s.productClient.GetSku(ctx, &product.GetSkuRequest{SkuId: item.SkuId})
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "商品 SKU %d 不存在", item.SkuId)
+		}
+		lines = append(lines, shippingLine{Price: money.Cents(prodResp.Price), Weight: int(prodResp.Weight), Quantity: int(item.Quantity)})
+	}
+
+	quote, err := s.calcShipping(addrResp.Address.ProvinceCode, lines)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "计算运费失败")
+	}
+
+	resp := &order.QuoteShippingResponse{
+		Deliverable:   quote.Deliverable,
+		GoodsAmount:   int64(quote.GoodsAmount),
+		ShippingFee:   int64(quote.Fee),
+		RuleName:      quote.RuleName,
+		FreeThreshold: int64(quote.FreeThreshold),
+	}
+	switch {
+	case !quote.Deliverable:
+		resp.Message = "该地区暂不支持配送"
+	case quote.FreeThreshold > 0 && quote.GoodsAmount < quote.FreeThreshold:
+		resp.Message = fmt.Sprintf("再买 %s 元包邮", quote.FreeThreshold-quote.GoodsAmount)
+	case quote.FreeThreshold > 0:
+		resp.Message = fmt.Sprintf("已满 %s 元包邮", quote.FreeThreshold)
+	}
+	return resp, nil
 }
 
 // ListOrders 查询订单列表 (RPC)
 func (s *server) ListOrders(ctx context.Context, req *order.ListOrdersRequest) (*order.ListOrdersResponse, error) {
 	var orders []model.Order
-	if err := s.db.Preload("Items").Where("user_id = ?", req.UserId).Order("created_at desc").Find(&orders).Error; err != nil {
+	if err := s.db.Preload("Items").Preload("Discounts").Where("user_id = ?", req.UserId).Order("created_at desc").Find(&orders).Error; err != nil {
 		return nil, status.Error(codes.Internal, "查询失败")
 	}
 	var respOrders []*order.OrderInfo
@@ -390,21 +907,36 @@ func (s *server) ListOrders(ctx context.Context, req *order.ListOrdersRequest) (
 				ProductId:   int64(item.ProductID),
 				ProductName: item.ProductName,
 				SkuName:     item.SkuName,
-				Price:       float32(item.Price),
+				Price:       int64(item.Price),
 				Quantity:    int32(item.Quantity),
 				Picture:     item.Picture,
 				IsReviewed:  item.IsReviewed, // 🔥 返回评价状态
+
+				DiscountAmount: int64(item.DiscountAmount),
+			})
+		}
+		var discounts []*order.OrderDiscountInfo
+		for _, d := range o.Discounts {
+			discounts = append(discounts, &order.OrderDiscountInfo{
+				CouponId:     d.CouponID,
+				UserCouponId: d.UserCouponID,
+				Name:         d.Name,
+				Amount:       int64(d.Amount),
 			})
 		}
 		respOrders = append(respOrders, &order.OrderInfo{
 			OrderNo:         o.OrderNo,
-			TotalAmount:     float32(o.TotalAmount),
+			TotalAmount:     int64(o.TotalAmount),
 			Status:          int32(o.Status),
 			CreatedAt:       o.CreatedAt.Format("2006-01-02 15:04:05"),
 			Items:           items,
 			ReceiverName:    o.ReceiverName,
 			ReceiverMobile:  o.ReceiverMobile,
 			ReceiverAddress: o.ReceiverAddress,
+			GoodsAmount:     int64(o.GoodsAmount),
+			ShippingFee:     int64(o.ShippingFee),
+			DiscountAmount:  int64(o.DiscountAmount),
+			Discounts:       discounts,
 		})
 	}
 	return &order.ListOrdersResponse{Orders: respOrders}, nil
@@ -435,9 +967,25 @@ func (s *server) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidRequ
 	if o.Status == 1 {
 		return &order.MarkOrderPaidResponse{Success: true}, nil
 	}
-	if err := s.db.Model(&o).UpdateColumn("status", 1).Error; err != nil {
+	// 仅待支付订单可置为已支付，与超时取消竞争时只有一方生效，优惠券不会既核销又释放
+	result := s.db.Model(&model.Order{}).Where("id = ? AND status = 0", o.ID).UpdateColumn("status", 1)
+	if result.Error != nil {
 		return nil, status.Error(codes.Internal, "更新状态失败")
 	}
+	if result.RowsAffected != 1 {
+		if err := s.db.Select("status").Where("id = ?", o.ID).First(&o).Error; err != nil {
+			return nil, status.Error(codes.Internal, "查询订单失败")
+		}
+		if o.Status == 1 {
+			return &order.MarkOrderPaidResponse{Success: true}, nil
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "订单状态为 %d，无法标记为已支付", o.Status)
+	}
+	if o.DiscountAmount > 0 {
+		if _, err := s.promoClient.RedeemCoupon(ctx, &promotion.OrderCouponRequest{OrderNo: o.OrderNo}); err != nil {
+			log.Printf("[严重错误] 订单 %s 核销优惠券失败: %v", o.OrderNo, err)
+		}
+	}
 	log.Printf("订单 %s 支付成功", req.OrderNo)
 	return &order.MarkOrderPaidResponse{Success: true}, nil
 }
@@ -459,9 +1007,15 @@ func (s *server) cancelOrderLogic(ctx context.Context, orderNo string) (*order.C
 		return &order.CancelOrderResponse{Success: true}, nil
 	}
 
-	if err := s.db.Model(&o).UpdateColumn("status", 2).Error; err != nil {
+	// 仅待支付订单可取消，与支付回调竞争时只有一方生效，已支付订单不会回补库存
+	result := s.db.Model(&model.Order{}).Where("id = ? AND status = 0", o.ID).UpdateColumn("status", 2)
+	if result.Error != nil {
 		return nil, status.Error(codes.Internal, "更新状态失败")
 	}
+	if result.RowsAffected != 1 {
+		log.Printf("订单 %s 状态已变更，跳过取消", orderNo)
+		return &order.CancelOrderResponse{Success: true}, nil
+	}
 
 	for _, item := range o.Items {
 		_, err := s.productClient.RollbackStock(ctx, &product.RollbackStockRequest{SkuId: int64(item.SkuID), Count: int32(item.Quantity)})
@@ -469,11 +1023,179 @@ func (s *server) cancelOrderLogic(ctx context.Context, orderNo string) (*order.C
 			log.Printf("[严重错误] 订单 %s 回滚库存失败: %v", orderNo, err)
 		}
 	}
+	if o.DiscountAmount > 0 {
+		if _, err := s.promoClient.ReleaseCoupon(ctx, &promotion.OrderCouponRequest{OrderNo: orderNo}); err != nil {
+			log.Printf("[严重错误] 订单 %s 释放优惠券失败: %v", orderNo, err)
+		}
+	}
 
 	log.Printf("订单 %s 已成功取消", orderNo)
 	return &order.CancelOrderResponse{Success: true}, nil
 }
 
+// AnonymizeUserOrders 注销账号时处理用户订单
+// 1. 已支付未发货的订单需先处理完，拒绝注销
+// 2. 待支付订单直接取消并回滚库存
+// 3. 抹去所有订单的收货人信息，金额与明细保留用于对账
+func (s *server) AnonymizeUserOrders(ctx context.Context, req *order.AnonymizeUserOrdersRequest) (*order.AnonymizeUserOrdersResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+
+	var unfinished int64
+	if err := s.db.Model(&model.Order{}).Where("user_id = ? AND status = ?", req.UserId, 1).Count(&unfinished).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询订单失败")
+	}
+	if unfinished > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "存在 %d 笔已支付未发货的订单，暂时无法注销", unfinished)
+	}
+
+	var pending []model.Order
+	if err := s.db.Where("user_id = ? AND status = ?", req.UserId, 0).Find(&pending).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询订单失败")
+	}
+	for _, o := range pending {
+		if _, err := s.cancelOrderLogic(ctx, o.OrderNo); err != nil {
+			return nil, status.Errorf(codes.Internal, "取消待支付订单 %s 失败: %v", o.OrderNo, err)
+		}
+	}
+
+	result := s.db.Model(&model.Order{}).Where("user_id = ?", req.UserId).Updates(map[string]interface{}{
+		"receiver_name":    "已注销用户",
+		"receiver_mobile":  "",
+		"receiver_address": "",
+	})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "更新订单失败")
+	}
+	log.Printf("[Order] 用户 %d 注销，已匿名化 %d 笔订单", req.UserId, result.RowsAffected)
+	return &order.AnonymizeUserOrdersResponse{Anonymized: result.RowsAffected}, nil
+}
+
+// ListProductSales 按商品汇总未取消订单的购买数量，与下单扣库存时累加、取消时扣减的销量口径一致
+func (s *server) ListProductSales(ctx context.Context, req *order.ListProductSalesRequest) (*order.ListProductSalesResponse, error) {
+	var rows []struct {
+		ProductID int64
+		Qty       int64
+	}
+	err := s.db.WithContext(ctx).Table("order_items oi").
+		Select("oi.product_id, SUM(oi.quantity) AS qty").
+		Joins("JOIN orders o ON o.id = oi.order_id").
+		Where("o.status <> ?", 2).
+		Group("oi.product_id").Scan(&rows).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询订单失败")
+	}
+	resp := &order.ListProductSalesResponse{}
+	for _, r := range rows {
+		resp.Sales = append(resp.Sales, &order.ProductSales{ProductId: r.ProductID, Quantity: r.Qty})
+	}
+	return resp, nil
+}
+
+// --- 运费规则 ---
+
+// 省级行政区划编码 (GB/T 2260)
+var provinceCodePattern = regexp.MustCompile(`^\d{2}0000$`)
+
+func toShippingRuleInfo(r *model.ShippingRule) *order.ShippingRuleInfo {
+	return &order.ShippingRuleInfo{
+		Id:             int64(r.ID),
+		Name:           r.Name,
+		Regions:        r.RegionList(),
+		Deliverable:    r.Deliverable,
+		Mode:           r.Mode,
+		FirstUnit:      int32(r.FirstUnit),
+		FirstFee:       int64(r.FirstFee),
+		AdditionalUnit: int32(r.AdditionalUnit),
+		AdditionalFee:  int64(r.AdditionalFee),
+		FreeThreshold:  int64(r.FreeThreshold),
+		Priority:       int32(r.Priority),
+		Enabled:        r.Enabled,
+	}
+}
+
+// ListShippingRules 全部运费规则 (含已停用的)，按匹配顺序排列
+func (s *server) ListShippingRules(ctx context.Context, req *order.ListShippingRulesRequest) (*order.ListShippingRulesResponse, error) {
+	var rules []model.ShippingRule
+	if err := s.db.WithContext(ctx).Order("priority DESC, id ASC").Find(&rules).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询运费规则失败")
+	}
+	resp := &order.ListShippingRulesResponse{}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, toShippingRuleInfo(&rules[i]))
+	}
+	return resp, nil
+}
+
+// SaveShippingRule 新增 / 修改运费规则 (id 为 0 时新增)
+func (s *server) SaveShippingRule(ctx context.Context, in *order.ShippingRuleInfo) (*order.SaveShippingRuleResponse, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "规则名称不能为空")
+	}
+	if in.Mode == "" {
+		in.Mode = model.ShippingByCount
+	}
+	if in.Mode != model.ShippingByCount && in.Mode != model.ShippingByWeight {
+		return nil, status.Error(codes.InvalidArgument, "计费方式只能是 count 或 weight")
+	}
+	if in.FirstUnit < 0 || in.AdditionalUnit < 0 || in.FirstFee < 0 || in.AdditionalFee < 0 || in.FreeThreshold < 0 {
+		return nil, status.Error(codes.InvalidArgument, "首件/续件与费用不能为负数")
+	}
+	for _, code := range in.Regions {
+		if !provinceCodePattern.MatchString(code) {
+			return nil, status.Errorf(codes.InvalidArgument, "省份编码无效: %s", code)
+		}
+	}
+
+	rule := model.ShippingRule{
+		ID:             uint(in.Id),
+		Name:           strings.TrimSpace(in.Name),
+		Regions:        strings.Join(in.Regions, ","),
+		Deliverable:    in.Deliverable,
+		Mode:           in.Mode,
+		FirstUnit:      int(in.FirstUnit),
+		FirstFee:       money.Cents(in.FirstFee),
+		AdditionalUnit: int(in.AdditionalUnit),
+		AdditionalFee:  money.Cents(in.AdditionalFee),
+		FreeThreshold:  money.Cents(in.FreeThreshold),
+		Priority:       int(in.Priority),
+		Enabled:        in.Enabled,
+	}
+	db := s.db.WithContext(ctx)
+	if rule.ID == 0 {
+		if err := db.Create(&rule).Error; err != nil {
+			log.Printf("[Error] 新增运费规则失败: %v", err)
+			return nil, status.Error(codes.Internal, "保存运费规则失败")
+		}
+		return &order.SaveShippingRuleResponse{Id: int64(rule.ID)}, nil
+	}
+
+	// Select("*") 保证 false / 0 值也会被写入
+	result := db.Model(&rule).Select("*").Omit("id", "created_at").Updates(&rule)
+	if result.Error != nil {
+		log.Printf("[Error] 修改运费规则 %d 失败: %v", rule.ID, result.Error)
+		return nil, status.Error(codes.Internal, "保存运费规则失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "运费规则不存在")
+	}
+	return &order.SaveShippingRuleResponse{Id: int64(rule.ID)}, nil
+}
+
+// DeleteShippingRule 删除运费规则
+func (s *server) DeleteShippingRule(ctx context.Context, req *order.DeleteShippingRuleRequest) (*order.DeleteShippingRuleResponse, error) {
+	result := s.db.WithContext(ctx).Where("id = ?", req.Id).Delete(&model.ShippingRule{})
+	if result.Error != nil {
+		log.Printf("[Error] 删除运费规则 %d 失败: %v", req.Id, result.Error)
+		return nil, status.Error(codes.Internal, "删除运费规则失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "运费规则不存在")
+	}
+	return &order.DeleteShippingRuleResponse{Success: true}, nil
+}
+
 // 🔥 新增：UpdateOrderStatus 用于更新主订单状态
 func (s *server) UpdateOrderStatus(ctx context.Context, req *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
 	err := s.db.Model(&model.Order{}).Where("order_no = ?", req.OrderNo).Update("status", req.Status).Error
@@ -507,12 +1229,21 @@ func main() {
 	if v := os.Getenv("CONSUL_ADDRESS"); v != "" {
 		c.Consul.Address = v
 	}
+	if v := os.Getenv("ORDER_PRICE_TOKEN_SECRET"); v != "" {
+		c.Order.PriceTokenSecret = v
+	}
+	if c.Order.PriceTokenSecret == "" {
+		log.Fatalf("未配置价格令牌签名密钥 (order.price_token_secret)")
+	}
+	if c.Order.PriceTokenTTL <= 0 {
+		c.Order.PriceTokenTTL = defaultPriceTokenTTL
+	}
 
 	db, err := database.InitMySQL(c.Mysql)
 	if err != nil {
 		log.Fatalf("初始化 MySQL 失败: %v", err)
 	}
-	db.AutoMigrate(&model.Order{}, &model.OrderItem{})
+	db.AutoMigrate(&model.Order{}, &model.OrderItem{}, &model.OrderDiscount{}, &model.ShippingRule{})
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -521,6 +1252,7 @@ func main() {
 	prodConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "product-service"), opts...)
 	cartConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "cart-service"), opts...)
 	addrConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "address-service"), opts...)
+	promoConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "promotion-service"), opts...)
 
 	s := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
@@ -530,6 +1262,15 @@ func main() {
 		productClient: product.NewProductServiceClient(prodConn),
 		cartClient:    cart.NewCartServiceClient(cartConn),
 		addressClient: address.NewAddressServiceClient(addrConn),
+		promoClient:   promotion.NewPromotionServiceClient(promoConn),
+
+		priceTokenSecret: []byte(c.Order.PriceTokenSecret),
+		priceTokenTTL:    time.Duration(c.Order.PriceTokenTTL) * time.Minute,
+
+		maxQuantityPerSku: c.Cart.MaxQuantityPerSku,
+	}
+	if srv.maxQuantityPerSku <= 0 {
+		srv.maxQuantityPerSku = defaultMaxQuantityPerSku
 	}
 
 	// 初始化 RabbitMQ (重试机制)
@@ -545,6 +1286,7 @@ func main() {
 		defer srv.mqConn.Close()
 		defer srv.mqCh.Close()
 		srv.startConsumer() // 启动消费者
+		srv.startOutboxRelay()
 	} else {
 		log.Println("[警告] RabbitMQ 未连接，自动取消和秒杀下单功能将失效！")
 	}
@@ -1,37 +1,66 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"go-ecommerce/pkg/money"
+)
 
 // Order 订单主表
 type Order struct {
-	ID          uint    `gorm:"primaryKey"`
-	OrderNo     string  `gorm:"type:varchar(64);uniqueIndex"`
-	UserID      int64   `gorm:"index"`
-	TotalAmount float64 `gorm:"type:decimal(10,2)"`
-	Status      int     `gorm:"default:0"` // 0:待支付 1:已支付 2:已取消
+	ID          uint        `gorm:"primaryKey"`
+	OrderNo     string      `gorm:"type:varchar(64);uniqueIndex"`
+	UserID      int64       `gorm:"index"`
+	TotalAmount money.Cents `gorm:"type:decimal(10,2)"` // 实付金额 = 商品金额 + 运费 - 优惠
+	Status      int         `gorm:"default:0"`          // 0:待支付 1:已支付 2:已取消
+
+	// 金额明细
+	GoodsAmount    money.Cents `gorm:"type:decimal(10,2)"`
+	ShippingFee    money.Cents `gorm:"type:decimal(10,2)"`
+	DiscountAmount money.Cents `gorm:"type:decimal(10,2)"`
 
 	// 地址快照字段
+	AddressID       int64  `gorm:"default:0"` // 下单时使用的地址 (地址删除为软删除，可据此回溯)
 	ReceiverName    string `gorm:"type:varchar(50)"`
 	ReceiverMobile  string `gorm:"type:varchar(20)"`
 	ReceiverAddress string `gorm:"type:varchar(255)"` // 省市区+详细地址的拼接
 
-	Items     []OrderItem `gorm:"foreignKey:OrderID"`
+	// 购物车结算快照：提交成功后通过 order.created 事件清理购物车
+	CheckoutID      string `gorm:"type:varchar(64);index"`
+	CartCleanupSent bool   `gorm:"default:false"` // order.created 事件已投递
+
+	Items     []OrderItem     `gorm:"foreignKey:OrderID"`
+	Discounts []OrderDiscount `gorm:"foreignKey:OrderID"` // 优惠明细，DiscountAmount 为其合计
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
 // OrderItem 订单明细表 (保持不变)
 type OrderItem struct {
-	ID          uint    `gorm:"primaryKey"`
-	OrderID     uint    `gorm:"index"`
-	ProductID   int64   `gorm:"index"`
-	SkuID       int64   `gorm:"index"`
-	ProductName string  `gorm:"type:varchar(100)"`
-	SkuName     string  `gorm:"type:varchar(100)"`
-	Price       float64 `gorm:"type:decimal(10,2)"`
-	Quantity    int     `gorm:"type:int"`
-	Picture     string  `gorm:"type:varchar(255)"`
-	IsReviewed  bool    `gorm:"column:is_reviewed;default:false"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID          uint        `gorm:"primaryKey"`
+	OrderID     uint        `gorm:"index"`
+	ProductID   int64       `gorm:"index"`
+	SkuID       int64       `gorm:"index"`
+	ProductName string      `gorm:"type:varchar(100)"`
+	SkuName     string      `gorm:"type:varchar(100)"`
+	Price       money.Cents `gorm:"type:decimal(10,2)"`
+	Quantity    int         `gorm:"type:int"`
+	Picture     string      `gorm:"type:varchar(255)"`
+	IsReviewed  bool        `gorm:"column:is_reviewed;default:false"`
+	// 分摊到该行的优惠金额 (退款按此计算)
+	DiscountAmount money.Cents `gorm:"type:decimal(10,2)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// OrderDiscount 订单优惠明细 (每张优惠券一条)
+type OrderDiscount struct {
+	ID           uint        `gorm:"primaryKey"`
+	OrderID      uint        `gorm:"index"`
+	OrderNo      string      `gorm:"type:varchar(64)"`
+	CouponID     int64       // 优惠券模板
+	UserCouponID int64       // 用户领取的优惠券，取消订单时据此释放
+	Name         string      `gorm:"type:varchar(64)"`
+	Amount       money.Cents `gorm:"type:decimal(10,2)"`
+	CreatedAt    time.Time
 }
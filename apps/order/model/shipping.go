@@ -0,0 +1,90 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"go-ecommerce/pkg/money"
+)
+
+// 运费计费方式
+const (
+	ShippingByCount  = "count"  // 按件数
+	ShippingByWeight = "weight" // 按重量 (克)
+)
+
+// ShippingRule 运费规则 (通过 AdminService 维护)
+// Regions 为空的规则是全国默认规则；多条规则命中同一省份时按 Priority 从高到低取第一条
+type ShippingRule struct {
+	ID             uint        `gorm:"primaryKey"`
+	Name           string      `gorm:"type:varchar(64)"`
+	Regions        string      `gorm:"type:varchar(1024)"` // 适用省份编码，逗号分隔
+	Deliverable    bool        `gorm:"default:true"`       // false 表示该区域不配送
+	Mode           string      `gorm:"type:varchar(16);default:'count'"`
+	FirstUnit      int         `gorm:"type:int"` // 首件数 / 首重 (克)
+	FirstFee       money.Cents `gorm:"type:decimal(10,2)"`
+	AdditionalUnit int         `gorm:"type:int"` // 续件数 / 续重 (克)，0 表示只收首费
+	AdditionalFee  money.Cents `gorm:"type:decimal(10,2)"`
+	FreeThreshold  money.Cents `gorm:"type:decimal(10,2)"` // 商品金额满额包邮，0 表示不包邮
+	Priority       int         `gorm:"type:int;default:0"`
+	Enabled        bool        `gorm:"default:true"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RegionList 拆分适用省份编码
+func (r *ShippingRule) RegionList() []string {
+	var out []string
+	for _, code := range strings.Split(r.Regions, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			out = append(out, code)
+		}
+	}
+	return out
+}
+
+// MatchShippingRule 按省份编码选取规则：优先命中指定省份的规则，其次是全国默认规则
+// rules 需按 Priority 从高到低排序；没有可用规则时返回 nil (免运费)
+func MatchShippingRule(rules []ShippingRule, provinceCode string) *ShippingRule {
+	var fallback *ShippingRule
+	for i := range rules {
+		r := &rules[i]
+		if !r.Enabled {
+			continue
+		}
+		regions := r.RegionList()
+		if len(regions) == 0 {
+			if fallback == nil {
+				fallback = r
+			}
+			continue
+		}
+		if provinceCode == "" {
+			continue
+		}
+		for _, code := range regions {
+			if code == provinceCode {
+				return r
+			}
+		}
+	}
+	return fallback
+}
+
+// Fee 计算运费
+// count 为商品总件数，weight 为总重量 (克)，goodsAmount 为商品金额 (用于满额包邮)
+func (r *ShippingRule) Fee(count, weight int, goodsAmount money.Cents) money.Cents {
+	if r.FreeThreshold > 0 && goodsAmount >= r.FreeThreshold {
+		return 0
+	}
+	units := count
+	if r.Mode == ShippingByWeight {
+		units = weight
+	}
+	fee := r.FirstFee
+	if units > r.FirstUnit && r.AdditionalUnit > 0 {
+		steps := (units - r.FirstUnit + r.AdditionalUnit - 1) / r.AdditionalUnit // 续件 / 续重向上取整
+		fee += r.AdditionalFee.Mul(int64(steps))
+	}
+	return fee
+}
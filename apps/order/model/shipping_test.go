@@ -0,0 +1,91 @@
+package model
+
+import (
+	"testing"
+
+	"go-ecommerce/pkg/money"
+)
+
+func TestMatchShippingRule(t *testing.T) {
+	// 按 Priority 从高到低排列，与 calcShipping 的查询顺序一致
+	rules := []ShippingRule{
+		{ID: 1, Name: "偏远地区停用", Regions: "650000,540000", Priority: 30, Enabled: false},
+		{ID: 2, Name: "江浙沪", Regions: "310000, 320000,330000", Priority: 20, Enabled: true},
+		{ID: 3, Name: "上海加急", Regions: "310000", Priority: 10, Enabled: true},
+		{ID: 4, Name: "全国默认", Priority: 5, Enabled: true},
+		{ID: 5, Name: "全国默认 (低优先级)", Priority: 0, Enabled: true},
+	}
+	cases := []struct {
+		name     string
+		rules    []ShippingRule
+		province string
+		want     uint // 0 表示没有命中规则
+	}{
+		{name: "多条规则命中取优先级高的", rules: rules, province: "310000", want: 2},
+		{name: "编码两侧空格忽略", rules: rules, province: "320000", want: 2},
+		{name: "未命中省份回退到第一条默认规则", rules: rules, province: "440000", want: 4},
+		{name: "停用的规则跳过", rules: rules, province: "650000", want: 4},
+		{name: "省份为空只匹配默认规则", rules: rules, province: "", want: 4},
+		{
+			name:     "指定省份规则优先于更高优先级的默认规则",
+			rules:    []ShippingRule{{ID: 1, Priority: 9, Enabled: true}, {ID: 2, Regions: "110000", Priority: 1, Enabled: true}},
+			province: "110000",
+			want:     2,
+		},
+		{
+			name:     "没有默认规则时未命中返回 nil",
+			rules:    []ShippingRule{{ID: 1, Regions: "110000", Enabled: true}},
+			province: "120000",
+		},
+		{
+			name:     "默认规则停用时返回 nil",
+			rules:    []ShippingRule{{ID: 1, Enabled: false}},
+			province: "120000",
+		},
+		{name: "没有规则", province: "110000"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MatchShippingRule(tc.rules, tc.province)
+			var id uint
+			if got != nil {
+				id = got.ID
+			}
+			if id != tc.want {
+				t.Fatalf("MatchShippingRule(%q) = rule %d, want %d", tc.province, id, tc.want)
+			}
+		})
+	}
+}
+
+func TestShippingRuleFee(t *testing.T) {
+	byCount := ShippingRule{Mode: ShippingByCount, FirstUnit: 1, FirstFee: 800, AdditionalUnit: 2, AdditionalFee: 300}
+	byWeight := ShippingRule{Mode: ShippingByWeight, FirstUnit: 1000, FirstFee: 1200, AdditionalUnit: 500, AdditionalFee: 250}
+	cases := []struct {
+		name   string
+		rule   ShippingRule
+		count  int
+		weight int
+		goods  money.Cents
+		want   money.Cents
+	}{
+		{name: "按件首件内", rule: byCount, count: 1, weight: 5000, want: 800},
+		{name: "按件续件整除", rule: byCount, count: 3, want: 1100},
+		{name: "按件续件不足一档向上取整", rule: byCount, count: 4, want: 1400},
+		{name: "按重量忽略件数", rule: byWeight, count: 10, weight: 1000, want: 1200},
+		{name: "按重量续重向上取整", rule: byWeight, count: 1, weight: 1001, want: 1450},
+		{name: "按重量续重多档", rule: byWeight, count: 1, weight: 2200, want: 1950},
+		{name: "未指定计费方式按件", rule: ShippingRule{FirstUnit: 1, FirstFee: 500, AdditionalUnit: 1, AdditionalFee: 100}, count: 3, weight: 99999, want: 700},
+		{name: "续件为 0 只收首费", rule: ShippingRule{Mode: ShippingByCount, FirstUnit: 1, FirstFee: 600}, count: 50, want: 600},
+		{name: "满额包邮", rule: ShippingRule{Mode: ShippingByCount, FirstUnit: 1, FirstFee: 800, FreeThreshold: 9900}, count: 1, goods: 9900, want: 0},
+		{name: "差一分不包邮", rule: ShippingRule{Mode: ShippingByCount, FirstUnit: 1, FirstFee: 800, FreeThreshold: 9900}, count: 1, goods: 9899, want: 800},
+		{name: "门槛为 0 不包邮", rule: byCount, count: 1, goods: 1000000, want: 800},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.rule.Fee(tc.count, tc.weight, tc.goods); got != tc.want {
+				t.Fatalf("Fee(%d, %d, %d) = %d, want %d", tc.count, tc.weight, tc.goods, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Package pricetoken 订单确认价格令牌
+//
+// PreviewOrder 把用户确认时的地址、商品行、优惠券与应付金额签名后返回给前端，
+// CreateOrder 重新计价后与令牌比对，保证实际收取的金额与用户确认的一致。
+package pricetoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalid       = errors.New("价格令牌无效")
+	ErrExpired       = errors.New("价格令牌已过期")
+	ErrMismatch      = errors.New("订单内容与价格令牌不一致")
+	ErrAmountChanged = errors.New("应付金额与价格令牌不一致")
+)
+
+// Claims 令牌内容
+type Claims struct {
+	UserID    int64  `json:"u"`
+	AddressID int64  `json:"a"`
+	Lines     string `json:"l"`           // 见 FormatLines
+	CouponID  int64  `json:"c,omitempty"` // 使用的用户优惠券
+	PayCents  int64  `json:"p"`           // 应付金额 (分)
+	ExpiresAt int64  `json:"e"`           // Unix 秒
+}
+
+// Line 商品行
+type Line struct {
+	SkuID    int64
+	Quantity int32
+}
+
+// FormatLines 商品行规范化为 "sku:qty,..." (按 SKU 升序)，与传入顺序无关
+func FormatLines(lines []Line) string {
+	sorted := append([]Line(nil), lines...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].SkuID < sorted[j].SkuID })
+	parts := make([]string, 0, len(sorted))
+	for _, l := range sorted {
+		parts = append(parts, fmt.Sprintf("%d:%d", l.SkuID, l.Quantity))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Sign 生成令牌：base64(JSON).base64(HMAC-SHA256)
+func Sign(secret []byte, c Claims) string {
+	payload, _ := json.Marshal(c)
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(sign(secret, body))
+}
+
+// Verify 校验签名与有效期
+func Verify(secret []byte, token string, now time.Time) (*Claims, error) {
+	body, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalid
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, sign(secret, body)) {
+		return nil, ErrInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	var c Claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, ErrInvalid
+	}
+	if now.Unix() > c.ExpiresAt {
+		return nil, ErrExpired
+	}
+	return &c, nil
+}
+
+// Compare 令牌内容与下单时重新计价的结果比对：用户、地址、商品行或优惠券不同返回 ErrMismatch，
+// 只有应付金额不同返回 ErrAmountChanged；不比较有效期
+func (c *Claims) Compare(want Claims) error {
+	if c.UserID != want.UserID || c.AddressID != want.AddressID || c.Lines != want.Lines || c.CouponID != want.CouponID {
+		return ErrMismatch
+	}
+	if c.PayCents != want.PayCents {
+		return ErrAmountChanged
+	}
+	return nil
+}
+
+func sign(secret []byte, body string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
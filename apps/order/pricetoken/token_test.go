@@ -0,0 +1,141 @@
+package pricetoken
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var secret = []byte("test-secret")
+
+func testClaims(now time.Time) Claims {
+	return Claims{
+		UserID:    7,
+		AddressID: 3,
+		Lines:     FormatLines([]Line{{SkuID: 2, Quantity: 1}, {SkuID: 1, Quantity: 5}}),
+		CouponID:  11,
+		PayCents:  12345,
+		ExpiresAt: now.Add(15 * time.Minute).Unix(),
+	}
+}
+
+func TestFormatLines(t *testing.T) {
+	got := FormatLines([]Line{{SkuID: 20, Quantity: 1}, {SkuID: 3, Quantity: 2}, {SkuID: 100, Quantity: 3}})
+	if want := "3:2,20:1,100:3"; got != want {
+		t.Fatalf("期望 %q，实际 %q", want, got)
+	}
+	if FormatLines(nil) != "" {
+		t.Fatal("没有商品行时应为空")
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	c := testClaims(now)
+	token := Sign(secret, c)
+
+	got, err := Verify(secret, token, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != c {
+		t.Fatalf("期望 %+v，实际 %+v", c, *got)
+	}
+	// 恰好到期时仍有效
+	if _, err := Verify(secret, token, time.Unix(c.ExpiresAt, 0)); err != nil {
+		t.Fatalf("到期时刻应仍有效: %v", err)
+	}
+}
+
+func TestVerifyExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	c := testClaims(now)
+	token := Sign(secret, c)
+	if _, err := Verify(secret, token, time.Unix(c.ExpiresAt+1, 0)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("期望 ErrExpired，实际 %v", err)
+	}
+}
+
+// resign 修改载荷但沿用原签名，模拟篡改
+func resign(t *testing.T, token string, modify func(c *Claims)) string {
+	t.Helper()
+	body, sig, _ := strings.Cut(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c Claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		t.Fatal(err)
+	}
+	modify(&c)
+	payload, _ = json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + sig
+}
+
+func TestVerifyTampered(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	token := Sign(secret, testClaims(now))
+	body, sig, _ := strings.Cut(token, ".")
+
+	cases := map[string]string{
+		"改为其他用户":      resign(t, token, func(c *Claims) { c.UserID = 8 }),
+		"改低应付金额":      resign(t, token, func(c *Claims) { c.PayCents = 1 }),
+		"改商品数量":       resign(t, token, func(c *Claims) { c.Lines = "1:50,2:1" }),
+		"去掉优惠券":       resign(t, token, func(c *Claims) { c.CouponID = 0 }),
+		"延长有效期":       resign(t, token, func(c *Claims) { c.ExpiresAt += 3600 }),
+		"其他密钥签名":      Sign([]byte("other-secret"), testClaims(now)),
+		"签名被截断":       body + "." + sig[:len(sig)-2],
+		"签名不是 base64": body + ".!!!",
+		"缺少签名":        body,
+		"载荷不是 base64": "!!!." + sig,
+		"载荷不是 JSON": func() string {
+			b := base64.RawURLEncoding.EncodeToString([]byte("not json"))
+			return b + "." + base64.RawURLEncoding.EncodeToString(sign(secret, b))
+		}(),
+		"空令牌": "",
+	}
+	for name, tok := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Verify(secret, tok, now); !errors.Is(err, ErrInvalid) {
+				t.Fatalf("期望 ErrInvalid，实际 %v", err)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	got, err := Verify(secret, Sign(secret, testClaims(now)), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		modify  func(c *Claims)
+		wantErr error
+	}{
+		{"一致", func(c *Claims) {}, nil},
+		{"商品行顺序不同", func(c *Claims) { c.Lines = FormatLines([]Line{{SkuID: 1, Quantity: 5}, {SkuID: 2, Quantity: 1}}) }, nil},
+		{"有效期不参与比对", func(c *Claims) { c.ExpiresAt = 0 }, nil},
+		// 签名有效的令牌被其他用户拿来下单
+		{"其他用户", func(c *Claims) { c.UserID = 8 }, ErrMismatch},
+		{"地址不同", func(c *Claims) { c.AddressID = 4 }, ErrMismatch},
+		{"商品数量不同", func(c *Claims) { c.Lines = FormatLines([]Line{{SkuID: 1, Quantity: 6}, {SkuID: 2, Quantity: 1}}) }, ErrMismatch},
+		{"优惠券不同", func(c *Claims) { c.CouponID = 0 }, ErrMismatch},
+		{"金额不同", func(c *Claims) { c.PayCents = 12346 }, ErrAmountChanged},
+		{"内容与金额都不同", func(c *Claims) { c.UserID, c.PayCents = 8, 1 }, ErrMismatch},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := testClaims(now)
+			tc.modify(&want)
+			if err := got.Compare(want); !errors.Is(err, tc.wantErr) {
+				t.Fatalf("期望 %v，实际 %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"go-ecommerce/pkg/config"
 	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/money"
 	"go-ecommerce/proto/order"
 	"go-ecommerce/proto/payment"
 
@@ -31,7 +32,7 @@ type server struct {
 
 // Pay 支付接口实现
 func (s *server) Pay(ctx context.Context, req *payment.PayRequest) (*payment.PayResponse, error) {
-	log.Printf("📥 [Payment] 收到支付请求: OrderNo=%s, Amount=%.2f", req.OrderNo, req.Amount)
+	log.Printf("📥 [Payment] 收到支付请求: OrderNo=%s, Amount=%s", req.OrderNo, money.Cents(req.Amount))
 
 	// 1. 模拟与第三方支付网关（支付宝/微信）的交互延迟 (0.5s - 1.5s)
 	time.Sleep(time.Duration(500+rand.Intn(1000)) * time.Millisecond)
@@ -0,0 +1,82 @@
+package model
+
+import "sort"
+
+// CategoryTree 分类树 (分类数量有限，按需整表加载后在内存中遍历)
+type CategoryTree struct {
+	byID     map[int64]*Category
+	children map[int64][]*Category
+}
+
+// NewCategoryTree 由全部分类构建分类树，同级按 Sort、ID 升序
+func NewCategoryTree(all []Category) *CategoryTree {
+	t := &CategoryTree{
+		byID:     make(map[int64]*Category, len(all)),
+		children: make(map[int64][]*Category),
+	}
+	for i := range all {
+		c := &all[i]
+		t.byID[int64(c.ID)] = c
+		t.children[c.ParentID] = append(t.children[c.ParentID], c)
+	}
+	for _, list := range t.children {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Sort != list[j].Sort {
+				return list[i].Sort < list[j].Sort
+			}
+			return list[i].ID < list[j].ID
+		})
+	}
+	return t
+}
+
+// Get 按 ID 查找分类
+func (t *CategoryTree) Get(id int64) (*Category, bool) {
+	c, ok := t.byID[id]
+	return c, ok
+}
+
+// Children 直接子分类，parentID 为 0 时返回一级分类
+func (t *CategoryTree) Children(parentID int64) []*Category {
+	return t.children[parentID]
+}
+
+// Descendants 返回分类自身及其所有子孙分类的 ID
+func (t *CategoryTree) Descendants(id int64) []int64 {
+	ids := []int64{id}
+	for i := 0; i < len(ids); i++ {
+		for _, c := range t.children[ids[i]] {
+			ids = append(ids, int64(c.ID))
+		}
+	}
+	return ids
+}
+
+// IsDescendant 判断 id 是否为 ancestor 自身或其子孙，用于防止把分类移动到自己的子树下
+func (t *CategoryTree) IsDescendant(id, ancestor int64) bool {
+	for seen := 0; id != 0 && seen <= len(t.byID); seen++ {
+		if id == ancestor {
+			return true
+		}
+		c, ok := t.byID[id]
+		if !ok {
+			return false
+		}
+		id = c.ParentID
+	}
+	return false
+}
+
+// Root 返回分类所属的一级分类，分类不存在时返回 nil
+func (t *CategoryTree) Root(id int64) *Category {
+	var root *Category
+	for seen := 0; id != 0 && seen <= len(t.byID); seen++ {
+		c, ok := t.byID[id]
+		if !ok {
+			break
+		}
+		root = c
+		id = c.ParentID
+	}
+	return root
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// 商品变更事件 (RabbitMQ)：商品服务写库时在同一事务内记录 ProductOutbox，
+// 提交后投递 product.changed，由索引消费者按商品当前状态更新搜索索引
+const (
+	ProductEventExchange  = "product.events"
+	ProductChangedRouting = "product.changed"
+	ProductIndexQueue     = "product.search.index" // 搜索索引消费者队列
+)
+
+// ProductChangedEvent 只携带商品 ID，消费者重新读取数据库，重复或乱序投递都不影响结果
+type ProductChangedEvent struct {
+	ProductIDs []int64 `json:"product_ids"`
+}
+
+// ProductOutbox 待投递的商品变更
+type ProductOutbox struct {
+	ID        int64     `gorm:"primaryKey"`
+	ProductID int64     `gorm:"not null"`
+	Sent      bool      `gorm:"default:false;index"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+func (ProductOutbox) TableName() string {
+	return "product_outbox"
+}
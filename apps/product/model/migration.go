@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// 一次性数据迁移
+const (
+	MigrationBackfillStats = "backfill_sales_rating" // 按已有订单与评价回填商品销量、评分
+)
+
+// DataMigration 已执行的一次性数据迁移，按 Name 去重，多个实例同时启动也只执行一次
+type DataMigration struct {
+	Name      string    `gorm:"primaryKey;type:varchar(64)"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (DataMigration) TableName() string {
+	return "data_migrations"
+}
@@ -1,32 +1,44 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"go-ecommerce/pkg/money"
+
+	"gorm.io/gorm"
+)
 
 // Product 商品 SPU
 type Product struct {
 	gorm.Model
-	Name        string  `gorm:"type:varchar(100);not null"`
-	Description string  `gorm:"type:text"`
-	CategoryID  int64   `gorm:"not null"`
-	Picture     string  `gorm:"type:varchar(255)"`
-	Price       float32 `gorm:"type:decimal(10,2)"` // 这里的 Price 是展示底价
+	Name        string      `gorm:"type:varchar(100);not null"`
+	Description string      `gorm:"type:text"`
+	CategoryID  int64       `gorm:"not null"`
+	Picture     string      `gorm:"type:varchar(255)"`
+	Price       money.Cents `gorm:"type:decimal(10,2)"`                       // 这里的 Price 是展示底价
+	Status      string      `gorm:"type:varchar(16);default:'on_sale';index"` // 见 status.go
+	OnShelfAt   *time.Time  // 定时上架时间，到点后由 Product Service 置为在售
+	OffShelfAt  *time.Time  // 定时下架时间
 }
 
-// Category 商品分类
+// Category 商品分类，ParentID 为 0 的是一级分类
 type Category struct {
 	gorm.Model
 	Name     string `gorm:"type:varchar(50);not null"`
-	ParentID int64  `gorm:"default:0"`
+	ParentID int64  `gorm:"default:0;index"`
+	Sort     int    `gorm:"default:0"` // 同级分类按 Sort 升序展示
 }
 
 // Sku 商品规格
 type Sku struct {
 	gorm.Model
-	ProductID int64   `gorm:"not null;index"`
-	Name      string  `gorm:"type:varchar(100);not null"` // 例如：3斤尝鲜装
-	Price     float32 `gorm:"type:decimal(10,2)"`
-	Stock     int     `gorm:"not null;default:0"`
-	Picture   string  `gorm:"type:varchar(255)"`
+	ProductID int64       `gorm:"not null;index"`
+	Name      string      `gorm:"type:varchar(100);not null"` // 例如：3斤尝鲜装
+	Price     money.Cents `gorm:"type:decimal(10,2)"`
+	Stock     int         `gorm:"not null;default:0"`
+	Picture   string      `gorm:"type:varchar(255)"`
+	SpecKey   string      `gorm:"type:varchar(255);default:''"` // 规格值组合键，见 SpecKey
+	Status    string      `gorm:"type:varchar(16);default:'on_sale'"`
 }
 
 func (Product) TableName() string {
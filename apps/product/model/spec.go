@@ -0,0 +1,159 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProductSpec 商品的规格维度，例如：重量、等级、包装
+type ProductSpec struct {
+	ID        int64  `gorm:"primaryKey"`
+	ProductID int64  `gorm:"not null;index"`
+	Name      string `gorm:"type:varchar(32);not null"`
+	Sort      int    `gorm:"default:0"` // 维度按 Sort 升序展示
+}
+
+// ProductSpecValue 规格维度的可选值，例如：3斤、5斤
+type ProductSpecValue struct {
+	ID        int64  `gorm:"primaryKey"`
+	ProductID int64  `gorm:"not null;index"`
+	SpecID    int64  `gorm:"not null;index"`
+	Value     string `gorm:"type:varchar(32);not null"`
+	Sort      int    `gorm:"default:0"`
+}
+
+func (ProductSpec) TableName() string {
+	return "product_specs"
+}
+
+func (ProductSpecValue) TableName() string {
+	return "product_spec_values"
+}
+
+// SpecMatrix 一个商品的规格矩阵：维度按 Sort 排序，每个 SKU 在每个维度上恰好选一个值
+type SpecMatrix struct {
+	Specs  []ProductSpec
+	Values map[int64][]ProductSpecValue // SpecID -> 可选值，按 Sort 排序
+	owner  map[int64]int64              // 规格值 ID -> SpecID
+	byID   map[int64]ProductSpecValue
+}
+
+// NewSpecMatrix 由同一商品的维度与规格值构建矩阵
+func NewSpecMatrix(specs []ProductSpec, values []ProductSpecValue) *SpecMatrix {
+	m := &SpecMatrix{
+		Specs:  append([]ProductSpec(nil), specs...),
+		Values: make(map[int64][]ProductSpecValue, len(specs)),
+		owner:  make(map[int64]int64, len(values)),
+		byID:   make(map[int64]ProductSpecValue, len(values)),
+	}
+	sort.SliceStable(m.Specs, func(i, j int) bool {
+		if m.Specs[i].Sort != m.Specs[j].Sort {
+			return m.Specs[i].Sort < m.Specs[j].Sort
+		}
+		return m.Specs[i].ID < m.Specs[j].ID
+	})
+	for _, v := range values {
+		m.Values[v.SpecID] = append(m.Values[v.SpecID], v)
+		m.owner[v.ID] = v.SpecID
+		m.byID[v.ID] = v
+	}
+	for id := range m.Values {
+		vs := m.Values[id]
+		sort.SliceStable(vs, func(i, j int) bool {
+			if vs[i].Sort != vs[j].Sort {
+				return vs[i].Sort < vs[j].Sort
+			}
+			return vs[i].ID < vs[j].ID
+		})
+	}
+	return m
+}
+
+// Empty 商品未定义规格维度 (SKU 只用自由文本名称区分)
+func (m *SpecMatrix) Empty() bool {
+	return len(m.Specs) == 0
+}
+
+// Resolve 校验 SKU 选择的规格值：每个维度恰好一个值
+// 返回按维度顺序排列的规格值，以及用于判重的组合键 (见 SpecKey)
+func (m *SpecMatrix) Resolve(valueIDs []int64) ([]ProductSpecValue, string, error) {
+	if m.Empty() {
+		if len(valueIDs) > 0 {
+			return nil, "", errors.New("该商品未设置规格维度")
+		}
+		return nil, "", nil
+	}
+	picked := make(map[int64]ProductSpecValue, len(valueIDs))
+	for _, id := range valueIDs {
+		specID, ok := m.owner[id]
+		if !ok {
+			return nil, "", fmt.Errorf("规格值 %d 不属于该商品", id)
+		}
+		if _, dup := picked[specID]; dup {
+			return nil, "", errors.New("同一规格维度只能选择一个值")
+		}
+		picked[specID] = m.byID[id]
+	}
+	out := make([]ProductSpecValue, 0, len(m.Specs))
+	for _, spec := range m.Specs {
+		v, ok := picked[spec.ID]
+		if !ok {
+			return nil, "", fmt.Errorf("请选择规格「%s」", spec.Name)
+		}
+		out = append(out, v)
+	}
+	return out, SpecKey(valueIDs), nil
+}
+
+// Ordered 按维度顺序返回 SKU 的规格值 ID，用于对外展示；无法识别的 ID 被忽略
+func (m *SpecMatrix) Ordered(key string) []int64 {
+	ids := ParseSpecKey(key)
+	pos := make(map[int64]int, len(m.Specs))
+	for i, spec := range m.Specs {
+		pos[spec.ID] = i
+	}
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := m.owner[id]; ok {
+			out = append(out, id)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return pos[m.owner[out[i]]] < pos[m.owner[out[j]]]
+	})
+	return out
+}
+
+// SkuName 由规格值拼出 SKU 名称，如 "5斤 一级 礼盒装"
+func SkuName(values []ProductSpecValue) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, v.Value)
+	}
+	return strings.Join(parts, " ")
+}
+
+// SpecKey 规格值组合键：ID 升序、逗号分隔，与维度顺序无关
+func SpecKey(valueIDs []int64) string {
+	ids := append([]int64(nil), valueIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseSpecKey 拆分组合键
+func ParseSpecKey(key string) []int64 {
+	var out []int64
+	for _, v := range strings.Split(key, ",") {
+		if id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package model
+
+// 商品 / SKU 状态
+const (
+	StatusDraft    = "draft"     // 草稿：未上架过，前台不可见
+	StatusOnSale   = "on_sale"   // 在售
+	StatusOffShelf = "off_shelf" // 已下架：前台不可购买
+	StatusDeleted  = "deleted"   // 已删除：保留数据供历史订单、评价反查
+)
+
+// ValidProductStatus 可由管理端直接设置的商品状态 (删除走 DeleteProduct)
+func ValidProductStatus(s string) bool {
+	return s == StatusDraft || s == StatusOnSale || s == StatusOffShelf
+}
+
+// ValidSkuStatus 可由管理端直接设置的 SKU 状态
+func ValidSkuStatus(s string) bool {
+	return s == StatusOnSale || s == StatusOffShelf
+}
+
+// EffectiveStatus SKU 对外的实际状态：商品不在售时以商品状态为准
+func EffectiveStatus(productStatus, skuStatus string) string {
+	if productStatus != StatusOnSale {
+		return productStatus
+	}
+	return skuStatus
+}
+
+// Sellable 商品与 SKU 均在售才可加购、下单
+func Sellable(productStatus, skuStatus string) bool {
+	return EffectiveStatus(productStatus, skuStatus) == StatusOnSale
+}
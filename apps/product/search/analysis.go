@@ -0,0 +1,204 @@
+package search
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// 中文分词方式
+const (
+	AnalyzerIK      = "ik"      // analysis-ik 插件：索引用 ik_max_word 细粒度切词，搜索用 ik_smart
+	AnalyzerSmartCN = "smartcn" // analysis-smartcn 插件 (官方)
+	AnalyzerCJK     = "cjk"     // 内置：按相邻两字切词，无需插件
+)
+
+// analyzerPlugins 分词方式依赖的插件
+var analyzerPlugins = map[string]string{
+	AnalyzerIK:      "analysis-ik",
+	AnalyzerSmartCN: "analysis-smartcn",
+}
+
+// pinyinPlugin 拼音检索依赖的插件
+const pinyinPlugin = "analysis-pinyin"
+
+// DefaultSynonyms 内置的果蔬同义词 (Solr 格式，逗号分隔的词互为同义词)
+var DefaultSynonyms = []string{
+	"土豆,马铃薯,洋芋",
+	"西红柿,番茄",
+	"圣女果,小番茄,樱桃番茄",
+	"红薯,地瓜,番薯,红苕",
+	"玉米,苞米,玉蜀黍,苞谷",
+	"香菜,芫荽",
+	"包菜,卷心菜,圆白菜,甘蓝",
+	"花菜,菜花,花椰菜",
+	"西兰花,青花菜,绿菜花",
+	"青椒,菜椒,甜椒",
+	"茄子,矮瓜",
+	"苦瓜,凉瓜",
+	"黄瓜,青瓜",
+	"南瓜,倭瓜",
+	"山药,淮山",
+	"芋头,芋艿",
+	"荸荠,马蹄",
+	"四季豆,芸豆,菜豆",
+	"猕猴桃,奇异果",
+	"菠萝,凤梨",
+	"草莓,士多啤梨",
+	"车厘子,大樱桃",
+	"柚子,文旦",
+}
+
+// Options 索引分析配置
+type Options struct {
+	Analyzer string   // 见 Analyzer* 常量，默认 ik
+	Synonyms []string // 为空使用 DefaultSynonyms
+	Pinyin   bool     // 商品名增加拼音子字段，支持拼音、首字母检索
+	Fuzzy    bool     // 关键词模糊匹配，容忍输入错一两个字符
+}
+
+// Resolve 按集群已安装的插件确定实际生效的配置：分词插件缺失时退回 cjk，拼音插件缺失时关闭拼音检索
+func (o Options) Resolve(plugins map[string]bool) Options {
+	if o.Analyzer == "" {
+		o.Analyzer = AnalyzerIK
+	}
+	// 未知的分词方式同样退回 cjk
+	if plugin, ok := analyzerPlugins[o.Analyzer]; !ok && o.Analyzer != AnalyzerCJK || ok && !plugins[plugin] {
+		o.Analyzer = AnalyzerCJK
+	}
+	if o.Pinyin && !plugins[pinyinPlugin] {
+		o.Pinyin = false
+	}
+	if len(o.Synonyms) == 0 {
+		o.Synonyms = DefaultSynonyms
+	}
+	return o
+}
+
+// analyzers 索引与搜索时使用的分析器；同义词只在搜索时展开，索引中保存原词
+func (o Options) analyzers() map[string]interface{} {
+	var index, query map[string]interface{}
+	switch o.Analyzer {
+	case AnalyzerIK:
+		index = map[string]interface{}{"type": "custom", "tokenizer": "ik_max_word", "filter": []string{"lowercase"}}
+		query = map[string]interface{}{"type": "custom", "tokenizer": "ik_smart", "filter": []string{"lowercase", "product_synonym"}}
+	case AnalyzerSmartCN:
+		index = map[string]interface{}{"type": "custom", "tokenizer": "smartcn_tokenizer", "filter": []string{"lowercase"}}
+		query = map[string]interface{}{"type": "custom", "tokenizer": "smartcn_tokenizer", "filter": []string{"lowercase", "product_synonym"}}
+	default:
+		index = map[string]interface{}{"type": "custom", "tokenizer": "standard", "filter": []string{"cjk_width", "lowercase", "cjk_bigram"}}
+		query = map[string]interface{}{"type": "custom", "tokenizer": "standard", "filter": []string{"cjk_width", "lowercase", "cjk_bigram", "product_synonym"}}
+	}
+	out := map[string]interface{}{
+		"product_index":  index,
+		"product_search": query,
+		// 联想按整个商品名做前缀匹配，不切词
+		"product_suggest": map[string]interface{}{"type": "custom", "tokenizer": "keyword", "filter": []string{"cjk_width", "lowercase"}},
+	}
+	if o.Pinyin {
+		out["product_pinyin"] = map[string]interface{}{"type": "custom", "tokenizer": "product_pinyin"}
+	}
+	return out
+}
+
+// settings 索引 settings.analysis
+func (o Options) settings() map[string]interface{} {
+	analysis := map[string]interface{}{
+		"analyzer": o.analyzers(),
+		"filter": map[string]interface{}{
+			"product_synonym": map[string]interface{}{"type": "synonym_graph", "synonyms": o.Synonyms},
+		},
+	}
+	if o.Pinyin {
+		analysis["tokenizer"] = map[string]interface{}{
+			"product_pinyin": map[string]interface{}{
+				"type":                      "pinyin",
+				"keep_first_letter":         true,
+				"keep_full_pinyin":          true,
+				"keep_joined_full_pinyin":   true,
+				"keep_original":             false,
+				"limit_first_letter_length": 16,
+				"lowercase":                 true,
+				"remove_duplicated_term":    true,
+			},
+		}
+	}
+	return map[string]interface{}{"analysis": analysis}
+}
+
+// properties 字段映射，与 Doc 对应
+func (o Options) properties() map[string]interface{} {
+	nameFields := map[string]interface{}{
+		"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256},
+	}
+	if o.Pinyin {
+		nameFields["pinyin"] = map[string]interface{}{"type": "text", "analyzer": "product_pinyin"}
+	}
+	return map[string]interface{}{
+		"id":          map[string]interface{}{"type": "long"},
+		"name":        map[string]interface{}{"type": "text", "analyzer": "product_index", "search_analyzer": "product_search", "fields": nameFields},
+		"description": map[string]interface{}{"type": "text", "analyzer": "product_index", "search_analyzer": "product_search"},
+		"category_id": map[string]interface{}{"type": "long"},
+		"picture":     map[string]interface{}{"type": "keyword", "index": false},
+		"price":       map[string]interface{}{"type": "long"},
+		"status":      map[string]interface{}{"type": "keyword"},
+		"in_stock":    map[string]interface{}{"type": "boolean"},
+		"sales":       map[string]interface{}{"type": "long"},
+		"rating":      map[string]interface{}{"type": "float"},
+		"suggest":     map[string]interface{}{"type": "completion", "analyzer": "product_suggest"},
+	}
+}
+
+// IndexBody 创建索引的请求体；mappings._meta.fingerprint 记录配置指纹，用于判断已有索引是否需要重建
+func (o Options) IndexBody() map[string]interface{} {
+	return map[string]interface{}{
+		"settings": o.settings(),
+		"mappings": map[string]interface{}{
+			"dynamic":    false, // 未声明的字段只保存在 _source 中，不自动建映射
+			"_meta":      map[string]interface{}{"fingerprint": Fingerprint(o)},
+			"properties": o.properties(),
+		},
+	}
+}
+
+// Fingerprint 分析配置与字段映射的指纹，配置不变则指纹不变
+func Fingerprint(o Options) string {
+	raw, _ := json.Marshal(map[string]interface{}{
+		"settings":   o.settings(),
+		"properties": o.properties(),
+	})
+	sum := sha1.Sum(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// searchFields 关键词检索的字段，名称权重高于描述
+func (o Options) searchFields() []string {
+	fields := []string{"name^3", "description"}
+	if o.Pinyin {
+		fields = append(fields, "name.pinyin")
+	}
+	return fields
+}
+
+// plugins 集群所有节点都安装了的插件
+func (ix *Indexer) plugins(ctx context.Context) (map[string]bool, error) {
+	res, err := ix.cli.NodesInfo().Metric("plugins").Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := make(map[string]int)
+	for _, node := range res.Nodes {
+		for _, p := range node.Plugins {
+			count[strings.ToLower(p.Name)]++
+		}
+	}
+	out := make(map[string]bool, len(count))
+	for name, n := range count {
+		if n == len(res.Nodes) {
+			out[name] = true
+		}
+	}
+	return out, nil
+}
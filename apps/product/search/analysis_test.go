@@ -0,0 +1,100 @@
+package search
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	all := map[string]bool{"analysis-ik": true, "analysis-smartcn": true, "analysis-pinyin": true}
+	cases := []struct {
+		name       string
+		in         Options
+		plugins    map[string]bool
+		analyzer   string
+		wantPinyin bool
+	}{
+		{"默认使用 ik", Options{Pinyin: true}, all, AnalyzerIK, true},
+		{"ik 未安装退回 cjk", Options{Analyzer: AnalyzerIK, Pinyin: true}, map[string]bool{}, AnalyzerCJK, false},
+		{"smartcn", Options{Analyzer: AnalyzerSmartCN}, all, AnalyzerSmartCN, false},
+		{"cjk 无需插件", Options{Analyzer: AnalyzerCJK}, nil, AnalyzerCJK, false},
+		{"未知分词方式退回 cjk", Options{Analyzer: "jieba"}, all, AnalyzerCJK, false},
+		{"只缺拼音插件", Options{Analyzer: AnalyzerIK, Pinyin: true}, map[string]bool{"analysis-ik": true}, AnalyzerIK, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.in.Resolve(c.plugins)
+			if got.Analyzer != c.analyzer {
+				t.Errorf("Analyzer = %q, want %q", got.Analyzer, c.analyzer)
+			}
+			if got.Pinyin != c.wantPinyin {
+				t.Errorf("Pinyin = %v, want %v", got.Pinyin, c.wantPinyin)
+			}
+			if len(got.Synonyms) == 0 {
+				t.Error("未配置同义词时应使用内置词典")
+			}
+		})
+	}
+}
+
+// bodyJSON 索引请求体序列化为字符串，便于检查
+func bodyJSON(t *testing.T, o Options) string {
+	t.Helper()
+	raw, err := json.Marshal(o.IndexBody())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
+
+func TestIndexBody(t *testing.T) {
+	ik := bodyJSON(t, Options{Analyzer: AnalyzerIK, Pinyin: true, Synonyms: []string{"西红柿,番茄"}})
+	for _, want := range []string{
+		`"tokenizer":"ik_max_word"`,
+		`"tokenizer":"ik_smart"`,
+		`"synonyms":["西红柿,番茄"]`,
+		`"type":"synonym_graph"`,
+		`"pinyin":{"analyzer":"product_pinyin","type":"text"}`,
+		`"search_analyzer":"product_search"`,
+		`"dynamic":false`,
+		`"fingerprint":"`,
+	} {
+		if !strings.Contains(ik, want) {
+			t.Errorf("ik 索引配置缺少 %s\n%s", want, ik)
+		}
+	}
+
+	cjk := bodyJSON(t, Options{Analyzer: AnalyzerCJK}.Resolve(nil))
+	if !strings.Contains(cjk, `"cjk_bigram"`) {
+		t.Errorf("cjk 索引配置应使用 cjk_bigram\n%s", cjk)
+	}
+	if strings.Contains(cjk, "pinyin") {
+		t.Errorf("未启用拼音时不应出现拼音配置\n%s", cjk)
+	}
+	if !strings.Contains(cjk, "马铃薯") {
+		t.Error("未配置同义词时应写入内置词典")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	base := Options{Analyzer: AnalyzerIK, Synonyms: []string{"土豆,马铃薯"}}
+	if Fingerprint(base) != Fingerprint(base) {
+		t.Fatal("相同配置的指纹应一致")
+	}
+	fuzzy := base
+	fuzzy.Fuzzy = true
+	if Fingerprint(base) != Fingerprint(fuzzy) {
+		t.Error("模糊匹配只影响查询，不应改变指纹")
+	}
+	changed := base
+	changed.Synonyms = []string{"土豆,马铃薯,洋芋"}
+	if Fingerprint(base) == Fingerprint(changed) {
+		t.Error("同义词变更后指纹应变化")
+	}
+	cjk := base
+	cjk.Analyzer = AnalyzerCJK
+	if Fingerprint(base) == Fingerprint(cjk) {
+		t.Error("分词方式变更后指纹应变化")
+	}
+}
@@ -0,0 +1,116 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// DefaultHealthInterval 默认健康检查间隔
+const DefaultHealthInterval = 10 * time.Second
+
+var errClusterRed = errors.New("集群状态为 red")
+
+// Conn ES 连接：启动时连不上不影响服务，后台定期重连并做健康检查
+// 不健康期间调用方应跳过 ES (搜索降级为数据库查询，索引更新留待恢复后重试)
+type Conn struct {
+	url      string
+	interval time.Duration
+
+	cli      atomic.Pointer[elastic.Client]
+	healthy  atomic.Bool
+	reported bool // 已记录过当前这次不可用，避免每次检查都打日志
+}
+
+// NewConn interval 为 0 时使用 DefaultHealthInterval
+func NewConn(url string, interval time.Duration) *Conn {
+	if interval <= 0 {
+		interval = DefaultHealthInterval
+	}
+	return &Conn{url: url, interval: interval}
+}
+
+// Client 已创建的客户端，从未连上过时为 nil
+func (c *Conn) Client() *elastic.Client {
+	return c.cli.Load()
+}
+
+// Healthy 最近一次健康检查是否通过
+func (c *Conn) Healthy() bool {
+	return c.healthy.Load()
+}
+
+// MarkDown 请求遇到连接错误或超时时立即标记不可用，直到下一次健康检查通过
+func (c *Conn) MarkDown() {
+	c.healthy.Store(false)
+}
+
+// Run 立即检查一次，之后每隔 interval 检查，直到 ctx 结束
+// 每次由不可用恢复为可用 (包括首次连上) 时调用 onUp
+func (c *Conn) Run(ctx context.Context, onUp func(cli *elastic.Client)) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.check(ctx, onUp)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check 未创建客户端时尝试创建，然后查询集群健康状态
+func (c *Conn) check(ctx context.Context, onUp func(cli *elastic.Client)) {
+	cli := c.cli.Load()
+	if cli == nil {
+		created, err := elastic.NewClient(
+			elastic.SetURL(c.url),
+			elastic.SetSniff(false),
+			elastic.SetHealthcheckInterval(c.interval),
+			elastic.SetHealthcheckTimeoutStartup(time.Second), // 连不上时尽快返回，由下一轮重试
+		)
+		if err != nil {
+			c.down(err)
+			return
+		}
+		c.cli.Store(created)
+		cli = created
+	}
+
+	pctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	res, err := cli.ClusterHealth().Do(pctx)
+	if err == nil && res.Status == "red" {
+		err = errClusterRed
+	}
+	if err != nil {
+		c.down(err)
+		return
+	}
+	c.reported = false
+	if !c.healthy.Swap(true) {
+		log.Printf("[ES] %s 可用", c.url)
+		if onUp != nil {
+			onUp(cli)
+		}
+	}
+}
+
+func (c *Conn) down(err error) {
+	c.healthy.Store(false)
+	if !c.reported {
+		log.Printf("[ES] %s 不可用，搜索降级为数据库查询，后台每 %s 重试: %v", c.url, c.interval, err)
+		c.reported = true
+	}
+}
+
+// IsConnErr 是否为连接类错误 (节点不可达、超时)，这类错误应调用 MarkDown
+func IsConnErr(err error) bool {
+	return elastic.IsConnErr(err) || elastic.IsTimeout(err) ||
+		errors.Is(err, elastic.ErrNoClient) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,240 @@
+// Package search 商品搜索索引 (Elasticsearch)
+//
+// 对外只使用别名 (默认 products) 读写，别名指向实际索引 <别名>_<时间戳>。
+// 全量重建时先写入新索引，完成后原子切换别名再删除旧索引，切换过程中搜索不中断。
+// 索引映射由本包显式创建 (见 analysis.go)，分词方式、同义词等配置变更后启动时自动重建。
+package search
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// DefaultAlias 默认索引别名
+const DefaultAlias = "products"
+
+// bulkSize 全量重建时每批写入的文档数
+const bulkSize = 500
+
+// Doc 商品索引文档
+type Doc struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	CategoryID  int64    `json:"category_id"`
+	Picture     string   `json:"picture"`
+	Price       int64    `json:"price"` // 分
+	Status      string   `json:"status"`
+	InStock     bool     `json:"in_stock"` // 有在售且有库存的 SKU
+	Sales       int64    `json:"sales"`
+	Rating      float64  `json:"rating"`            // 平均评分，暂无评价为 0
+	Suggest     *Suggest `json:"suggest,omitempty"` // 搜索联想，只有在售商品才写入
+}
+
+// Indexer 维护商品索引
+type Indexer struct {
+	cli   *elastic.Client
+	alias string
+
+	mu       sync.RWMutex
+	opts     Options // 配置的分析选项
+	resolved bool    // opts 是否已按集群插件确定
+}
+
+// NewIndexer alias 为空时使用 DefaultAlias
+func NewIndexer(cli *elastic.Client, alias string, opts Options) *Indexer {
+	if alias == "" {
+		alias = DefaultAlias
+	}
+	return &Indexer{cli: cli, alias: alias, opts: opts}
+}
+
+// Alias 读写使用的别名
+func (ix *Indexer) Alias() string {
+	return ix.alias
+}
+
+// Options 当前的分析选项 (查询集群插件之前为配置值)
+func (ix *Indexer) Options() Options {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.opts
+}
+
+// resolve 首次建索引前查询集群插件，确定实际生效的分析选项
+func (ix *Indexer) resolve(ctx context.Context) (Options, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if ix.resolved {
+		return ix.opts, nil
+	}
+	plugins, err := ix.plugins(ctx)
+	if err != nil {
+		return Options{}, fmt.Errorf("查询 ES 插件失败: %w", err)
+	}
+	ix.opts = ix.opts.Resolve(plugins)
+	ix.resolved = true
+	return ix.opts, nil
+}
+
+// UpToDate 索引存在且映射与当前配置一致；不存在、早期隐式创建或配置变更后返回 false，需要全量重建
+func (ix *Indexer) UpToDate(ctx context.Context) (bool, error) {
+	opts, err := ix.resolve(ctx)
+	if err != nil {
+		return false, err
+	}
+	res, err := ix.cli.GetMapping().Index(ix.alias).Do(ctx)
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	want := Fingerprint(opts)
+	if len(res) == 0 {
+		return false, nil
+	}
+	for _, v := range res {
+		if mappingFingerprint(v) != want {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// mappingFingerprint 取出 GetMapping 结果中单个索引的 mappings._meta.fingerprint
+func mappingFingerprint(v interface{}) string {
+	index, _ := v.(map[string]interface{})
+	mappings, _ := index["mappings"].(map[string]interface{})
+	meta, _ := mappings["_meta"].(map[string]interface{})
+	fp, _ := meta["fingerprint"].(string)
+	return fp
+}
+
+// Upsert 写入或覆盖单个文档
+func (ix *Indexer) Upsert(ctx context.Context, doc *Doc) error {
+	_, err := ix.cli.Index().Index(ix.alias).Id(strconv.FormatInt(doc.ID, 10)).BodyJson(doc).Do(ctx)
+	return err
+}
+
+// Delete 删除单个文档，文档不存在视为成功
+func (ix *Indexer) Delete(ctx context.Context, id int64) error {
+	_, err := ix.cli.Delete().Index(ix.alias).Id(strconv.FormatInt(id, 10)).Do(ctx)
+	if err != nil && !elastic.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// BulkWriter 全量重建时批量写入新索引
+type BulkWriter struct {
+	ctx   context.Context
+	bulk  *elastic.BulkService
+	count int
+}
+
+// Add 加入一个文档，攒满一批后写入
+func (w *BulkWriter) Add(doc *Doc) error {
+	w.bulk.Add(elastic.NewBulkIndexRequest().Id(strconv.FormatInt(doc.ID, 10)).Doc(doc))
+	w.count++
+	if w.bulk.NumberOfActions() >= bulkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *BulkWriter) flush() error {
+	if w.bulk.NumberOfActions() == 0 {
+		return nil
+	}
+	res, err := w.bulk.Do(w.ctx)
+	if err != nil {
+		return err
+	}
+	if res.Errors {
+		if failed := res.Failed(); len(failed) > 0 && failed[0].Error != nil {
+			return fmt.Errorf("批量写入失败: %s", failed[0].Error.Reason)
+		}
+		return fmt.Errorf("批量写入失败")
+	}
+	return nil
+}
+
+// Reindex 全量重建：创建新索引，由 load 写入全部文档，然后把别名原子切换到新索引并删除旧索引
+// 返回新索引名与写入的文档数；失败时删除新索引，别名保持不变
+func (ix *Indexer) Reindex(ctx context.Context, load func(w *BulkWriter) error) (string, int, error) {
+	opts, err := ix.resolve(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	index := fmt.Sprintf("%s_%s", ix.alias, time.Now().Format("20060102150405"))
+	if _, err := ix.cli.CreateIndex(index).BodyJson(opts.IndexBody()).Do(ctx); err != nil {
+		return "", 0, fmt.Errorf("创建索引 %s 失败: %w", index, err)
+	}
+
+	w := &BulkWriter{ctx: ctx, bulk: ix.cli.Bulk().Index(index)}
+	err = load(w)
+	if err == nil {
+		err = w.flush()
+	}
+	if err == nil {
+		_, err = ix.cli.Refresh(index).Do(ctx)
+	}
+	if err == nil {
+		err = ix.swap(ctx, index)
+	}
+	if err != nil {
+		ix.cli.DeleteIndex(index).Do(context.Background())
+		return "", 0, err
+	}
+	return index, w.count, nil
+}
+
+// swap 别名切换到 index：一次 _aliases 请求内移除旧关联 (早期直接以别名命名的索引整体删除) 并关联新索引
+func (ix *Indexer) swap(ctx context.Context, index string) error {
+	old, err := ix.aliasedIndices(ctx)
+	if err != nil {
+		return err
+	}
+	actions := []elastic.AliasAction{}
+	for _, name := range old {
+		actions = append(actions, elastic.NewAliasRemoveAction(ix.alias).Index(name))
+	}
+	if len(old) == 0 {
+		// 别名不存在但有同名索引：这是启用别名之前隐式创建的索引
+		exists, err := ix.cli.IndexExists(ix.alias).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			actions = append(actions, elastic.NewAliasRemoveIndexAction(ix.alias))
+		}
+	}
+	actions = append(actions, elastic.NewAliasAddAction(ix.alias).Index(index))
+	if _, err := ix.cli.Alias().Action(actions...).Do(ctx); err != nil {
+		return fmt.Errorf("切换别名失败: %w", err)
+	}
+	for _, name := range old {
+		if name != index {
+			ix.cli.DeleteIndex(name).Do(ctx)
+		}
+	}
+	return nil
+}
+
+// aliasedIndices 当前别名指向的索引
+func (ix *Indexer) aliasedIndices(ctx context.Context) ([]string, error) {
+	res, err := ix.cli.Aliases().Alias(ix.alias).Do(ctx)
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.IndicesByAlias(ix.alias), nil
+}
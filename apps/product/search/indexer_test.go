@@ -0,0 +1,356 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// fakeES 模拟 ES 的 HTTP 接口，按 "方法 路径" 返回预设响应并记录请求
+type fakeES struct {
+	t      *testing.T
+	mu     sync.Mutex
+	routes map[string]func(body string) (int, string)
+	calls  map[string]string // "方法 路径" -> 最近一次请求体
+}
+
+func newFakeES(t *testing.T) *fakeES {
+	return &fakeES{t: t, routes: map[string]func(string) (int, string){}, calls: map[string]string{}}
+}
+
+func (f *fakeES) on(route string, fn func(body string) (int, string)) {
+	f.routes[route] = fn
+}
+
+// handler 路由以 * 结尾时按前缀匹配，用于带时间戳的索引名
+func (f *fakeES) handler(w http.ResponseWriter, r *http.Request) {
+	raw, _ := io.ReadAll(r.Body)
+	key := r.Method + " " + r.URL.Path
+	f.mu.Lock()
+	f.calls[key] = string(raw)
+	f.mu.Unlock()
+
+	fn, ok := f.routes[key]
+	if !ok {
+		for route, h := range f.routes {
+			if strings.HasSuffix(route, "*") && strings.HasPrefix(key, strings.TrimSuffix(route, "*")) {
+				fn, ok = h, true
+				break
+			}
+		}
+	}
+	if !ok {
+		f.t.Errorf("未预期的请求: %s", key)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	code, resp := fn(string(raw))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	io.WriteString(w, resp)
+}
+
+// call 取出某个请求的请求体
+func (f *fakeES) call(prefix string) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for key, body := range f.calls {
+		if strings.HasPrefix(key, prefix) {
+			return body, true
+		}
+	}
+	return "", false
+}
+
+func (f *fakeES) client() *elastic.Client {
+	ts := httptest.NewServer(http.HandlerFunc(f.handler))
+	f.t.Cleanup(ts.Close)
+	cli, err := elastic.NewClient(elastic.SetURL(ts.URL), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return cli
+}
+
+func reply(code int, body string) func(string) (int, string) {
+	return func(string) (int, string) { return code, body }
+}
+
+// plugins 节点插件列表响应
+func plugins(names ...string) string {
+	list := make([]string, 0, len(names))
+	for _, n := range names {
+		list = append(list, `{"name":"`+n+`"}`)
+	}
+	return `{"_nodes":{"total":1},"cluster_name":"test","nodes":{"n1":{"name":"n1","plugins":[` + strings.Join(list, ",") + `]}}}`
+}
+
+const notFound = `{"error":{"type":"index_not_found_exception"},"status":404}`
+
+func TestReindexCreatesIndexWithMapping(t *testing.T) {
+	es := newFakeES(t)
+	es.on("GET /_nodes/_all/plugins", reply(200, plugins("analysis-ik")))
+	es.on("PUT /products_*", reply(200, `{"acknowledged":true,"index":"products_x"}`))
+	es.on("POST /products_*", func(body string) (int, string) {
+		return 200, `{"took":1,"errors":false,"items":[{"index":{"_id":"1","status":201}}],"_shards":{"total":1,"successful":1}}`
+	})
+	es.on("GET /_alias/products", reply(404, `{"error":"alias [products] missing","status":404}`))
+	es.on("HEAD /products", reply(404, ""))
+	es.on("POST /_aliases", reply(200, `{"acknowledged":true}`))
+
+	ix := NewIndexer(es.client(), "", Options{Analyzer: AnalyzerIK, Pinyin: true})
+	index, count, err := ix.Reindex(context.Background(), func(w *BulkWriter) error {
+		return w.Add(&Doc{ID: 1, Name: "红富士苹果", Status: "on_sale", Price: 1990})
+	})
+	if err != nil {
+		t.Fatalf("Reindex: %v", err)
+	}
+	if !strings.HasPrefix(index, "products_") || count != 1 {
+		t.Fatalf("index = %s, count = %d", index, count)
+	}
+
+	created, ok := es.call("PUT /products_")
+	if !ok {
+		t.Fatal("未创建索引")
+	}
+	var body struct {
+		Mappings struct {
+			Meta       map[string]string          `json:"_meta"`
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"mappings"`
+	}
+	if err := json.Unmarshal([]byte(created), &body); err != nil {
+		t.Fatal(err)
+	}
+	// 拼音插件未安装：ik 生效、拼音关闭
+	opts := ix.Options()
+	if opts.Analyzer != AnalyzerIK || opts.Pinyin {
+		t.Errorf("生效配置 = %+v", opts)
+	}
+	if body.Mappings.Meta["fingerprint"] != Fingerprint(opts) {
+		t.Errorf("指纹 = %q, want %q", body.Mappings.Meta["fingerprint"], Fingerprint(opts))
+	}
+	for _, field := range []string{"name", "price", "in_stock", "sales", "rating", "suggest"} {
+		if _, ok := body.Mappings.Properties[field]; !ok {
+			t.Errorf("映射缺少字段 %s", field)
+		}
+	}
+
+	aliases, _ := es.call("POST /_aliases")
+	if !strings.Contains(aliases, `"add"`) || !strings.Contains(aliases, index) {
+		t.Errorf("别名未切换到新索引: %s", aliases)
+	}
+}
+
+func TestReindexPluginsUnavailable(t *testing.T) {
+	es := newFakeES(t)
+	es.on("GET /_nodes/_all/plugins", reply(500, `{"error":"boom","status":500}`))
+
+	ix := NewIndexer(es.client(), "", Options{})
+	if _, _, err := ix.Reindex(context.Background(), func(*BulkWriter) error { return nil }); err == nil {
+		t.Fatal("查询插件失败时不应按默认配置建索引")
+	}
+	if _, ok := es.call("PUT "); ok {
+		t.Error("不应创建索引")
+	}
+}
+
+func TestUpToDate(t *testing.T) {
+	opts := Options{Analyzer: AnalyzerCJK}.Resolve(nil)
+	mapping := func(fp string) string {
+		return `{"products_1":{"mappings":{"_meta":{"fingerprint":"` + fp + `"},"properties":{}}}}`
+	}
+	cases := []struct {
+		name string
+		code int
+		resp string
+		want bool
+	}{
+		{"索引不存在", 404, notFound, false},
+		{"早期隐式创建的索引", 200, `{"products":{"mappings":{"properties":{}}}}`, false},
+		{"配置已变更", 200, mapping("stale"), false},
+		{"与配置一致", 200, mapping(Fingerprint(opts)), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			es := newFakeES(t)
+			es.on("GET /_nodes/_all/plugins", reply(200, plugins()))
+			es.on("GET /products/_mapping/_all", reply(c.code, c.resp))
+
+			ok, err := NewIndexer(es.client(), "", Options{Analyzer: AnalyzerCJK}).UpToDate(context.Background())
+			if err != nil {
+				t.Fatalf("UpToDate: %v", err)
+			}
+			if ok != c.want {
+				t.Errorf("UpToDate = %v, want %v", ok, c.want)
+			}
+		})
+	}
+}
+
+func TestSearchSource(t *testing.T) {
+	q := Query{
+		Text:        "西红柿",
+		CategoryIDs: []int64{3, 4},
+		MinPrice:    1000,
+		InStock:     true,
+		Sort:        SortPriceAsc,
+		Size:        10,
+		Facets:      true,
+	}
+	src, err := q.Source(Options{Pinyin: true, Fuzzy: true}).Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := json.Marshal(src)
+	got := string(raw)
+	for _, want := range []string{
+		`"name^3"`,
+		`"name.pinyin"`,
+		`"fuzziness":"AUTO"`,
+		`"post_filter"`,
+		`"category_id":[3,4]`,
+		`"in_stock":true`,
+		`"categories"`,
+		`"prices"`,
+		`{"price":{"order":"asc"}}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("查询缺少 %s\n%s", want, got)
+		}
+	}
+
+	plain, _ := (&Query{Text: "苹果", Size: 10}).Source(Options{}).Source()
+	raw, _ = json.Marshal(plain)
+	if s := string(raw); strings.Contains(s, "pinyin") || strings.Contains(s, "fuzziness") || strings.Contains(s, "aggregations") {
+		t.Errorf("未启用的功能不应出现在查询中\n%s", s)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	es := newFakeES(t)
+	es.on("POST /products/_search", reply(200, `{"hits":{"total":{"value":0},"hits":[]},"suggest":{"name":[{"text":"红","offset":0,"length":1,"options":[{"text":"红富士苹果","_score":120},{"text":"红心火龙果","_score":30}]}]}}`))
+
+	got, err := NewIndexer(es.client(), "", Options{}).Suggest(context.Background(), "红", 5)
+	if err != nil {
+		t.Fatalf("Suggest: %v", err)
+	}
+	if strings.Join(got, ",") != "红富士苹果,红心火龙果" {
+		t.Errorf("Suggest = %v", got)
+	}
+	body, _ := es.call("POST /products/_search")
+	for _, want := range []string{`"field":"suggest"`, `"prefix":"红"`, `"skip_duplicates":true`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("请求缺少 %s: %s", want, body)
+		}
+	}
+}
+
+func TestNewHighlight(t *testing.T) {
+	source := func(hl interface{ Source() (interface{}, error) }) string {
+		src, err := hl.Source()
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := json.Marshal(src)
+		return string(raw)
+	}
+
+	hl, err := NewHighlight("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := source(hl)
+	for _, want := range []string{`"pre_tags":["\u003cem\u003e"]`, `"encoder":"html"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("默认高亮缺少 %s: %s", want, got)
+		}
+	}
+	if strings.Contains(got, "style") {
+		t.Errorf("默认高亮不应带样式: %s", got)
+	}
+
+	// 原文总是转义，与标记是否为 HTML 无关
+	for _, tags := range [][2]string{{"【", "】"}, {"<mark>", "</mark>"}, {`<span class="hl">`, "</span>"}} {
+		hl, err := NewHighlight(tags[0], tags[1])
+		if err != nil {
+			t.Fatalf("%v: %v", tags, err)
+		}
+		if got := source(hl); !strings.Contains(got, `"encoder":"html"`) {
+			t.Errorf("%v 应转义原文: %s", tags, got)
+		}
+	}
+
+	for _, tags := range [][2]string{
+		{"<b>", ""},                    // 只设置一个标记
+		{strings.Repeat("<", 40), ">"}, // 过长
+		{"<script>", "</script>"},      // 不在白名单
+		{"<img src=x onerror=alert(1)>", "</img>"},
+		{`<span onclick="x">`, "</span>"}, // 只允许 class 属性
+		{`<span class="a"b">`, "</span>"},
+		{"<em>", "</strong>"}, // 首尾不配对
+		{"<em>", "<em>"},
+		{"[", "</em>"},
+		{"&lt;", "&gt;"}, // 文本标记不能包含 HTML 字符
+		{"【", "】<"},
+	} {
+		if _, err := NewHighlight(tags[0], tags[1]); err == nil {
+			t.Errorf("%q %q 应报错", tags[0], tags[1])
+		}
+	}
+}
+
+func TestConnReconnect(t *testing.T) {
+	// 不可达的地址：不创建客户端，保持不可用
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	c := NewConn(down.URL, 0)
+	c.check(context.Background(), func(*elastic.Client) { t.Error("不可用时不应回调") })
+	if c.Healthy() || c.Client() != nil {
+		t.Fatal("ES 不可达时应标记为不可用")
+	}
+
+	es := newFakeES(t)
+	healthy := `{"cluster_name":"test","status":"green"}`
+	es.on("HEAD /", reply(200, ""))
+	es.on("GET /", reply(200, `{"version":{"number":"7.17.13"}}`))
+	es.on("GET /_cluster/health", reply(200, healthy))
+	ts := httptest.NewServer(http.HandlerFunc(es.handler))
+	defer ts.Close()
+
+	ups := 0
+	onUp := func(*elastic.Client) { ups++ }
+	c = NewConn(ts.URL, 0)
+	c.check(context.Background(), onUp)
+	if !c.Healthy() || c.Client() == nil || ups != 1 {
+		t.Fatalf("首次连上: healthy=%v ups=%d", c.Healthy(), ups)
+	}
+	c.check(context.Background(), onUp)
+	if ups != 1 {
+		t.Errorf("持续可用时不应重复回调: ups=%d", ups)
+	}
+
+	// 请求失败后标记不可用，下一次检查通过即恢复
+	c.MarkDown()
+	if c.Healthy() {
+		t.Fatal("MarkDown 后应不可用")
+	}
+	c.check(context.Background(), onUp)
+	if !c.Healthy() || ups != 2 {
+		t.Errorf("恢复: healthy=%v ups=%d", c.Healthy(), ups)
+	}
+
+	// 集群 red 视为不可用
+	es.on("GET /_cluster/health", reply(200, `{"cluster_name":"test","status":"red"}`))
+	c.check(context.Background(), onUp)
+	if c.Healthy() {
+		t.Error("集群 red 时应不可用")
+	}
+}
@@ -0,0 +1,286 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/olivere/elastic/v7"
+
+	"go-ecommerce/apps/product/model"
+)
+
+// 排序方式
+const (
+	SortDefault   = ""           // 有关键词按相关度，否则按 ID
+	SortPriceAsc  = "price_asc"  // 价格从低到高
+	SortPriceDesc = "price_desc" // 价格从高到低
+	SortSales     = "sales"      // 销量从高到低
+	SortRating    = "rating"     // 评分从高到低
+)
+
+// ValidSort 是否为支持的排序方式
+func ValidSort(s string) bool {
+	switch s {
+	case SortDefault, SortPriceAsc, SortPriceDesc, SortSales, SortRating:
+		return true
+	}
+	return false
+}
+
+// PriceRange 价格区间 [From, To)，单位分；To 为 0 表示无上限
+type PriceRange struct {
+	From int64
+	To   int64
+}
+
+// PriceRanges 价格分面的固定区间：0-10、10-30、30-50、50-100、100-200、200 元以上
+var PriceRanges = []PriceRange{
+	{0, 1000}, {1000, 3000}, {3000, 5000}, {5000, 10000}, {10000, 20000}, {20000, 0},
+}
+
+// 默认高亮标记，不带样式，由前端决定展示效果
+const (
+	DefaultHighlightPre  = "<em>"
+	DefaultHighlightPost = "</em>"
+)
+
+// maxHighlightTag 高亮标记的最大长度
+const maxHighlightTag = 32
+
+// highlightTags 允许作为高亮标记的 HTML 标签，可带 class 属性
+var highlightTags = map[string]bool{"em": true, "strong": true, "b": true, "i": true, "mark": true, "span": true}
+
+// htmlTagPattern <tag> 或 <tag class="xxx">
+var htmlTagPattern = regexp.MustCompile(`^<([a-z]+)(?: class="[A-Za-z0-9_\- ]*")?>$`)
+
+// checkHighlightTags 标记为 HTML 时只接受白名单中的标签且首尾配对；
+// 其它标记 (如【】) 不能包含 HTML 特殊字符，避免标记本身被当作 HTML 渲染
+func checkHighlightTags(pre, post string) error {
+	if m := htmlTagPattern.FindStringSubmatch(pre); m != nil {
+		if !highlightTags[m[1]] {
+			return fmt.Errorf("不支持的高亮标签: %s", m[1])
+		}
+		if post != "</"+m[1]+">" {
+			return errors.New("高亮结束标记与开始标签不匹配")
+		}
+		return nil
+	}
+	if strings.ContainsAny(pre+post, `<>&"'`) {
+		return errors.New("高亮标记只支持 em、strong、b、i、mark、span 标签或不含 HTML 字符的文本")
+	}
+	return nil
+}
+
+// NewHighlight 名称、描述的高亮设置；pre、post 为空时使用默认标记
+// 片段中的原文总是做 HTML 转义，避免商品名、描述中的内容被当作标签渲染；标记本身见 checkHighlightTags
+func NewHighlight(pre, post string) (*elastic.Highlight, error) {
+	if pre == "" && post == "" {
+		pre, post = DefaultHighlightPre, DefaultHighlightPost
+	}
+	if pre == "" || post == "" {
+		return nil, errors.New("高亮标记需同时设置")
+	}
+	if len(pre) > maxHighlightTag || len(post) > maxHighlightTag {
+		return nil, errors.New("高亮标记过长")
+	}
+	if err := checkHighlightTags(pre, post); err != nil {
+		return nil, err
+	}
+	return elastic.NewHighlight().
+		Fields(
+			elastic.NewHighlighterField("name").NumOfFragments(0), // 名称整段返回
+			elastic.NewHighlighterField("description").FragmentSize(100).NumOfFragments(1),
+		).
+		PreTags(pre).
+		PostTags(post).
+		Encoder("html"), nil
+}
+
+// Query 商品搜索条件
+type Query struct {
+	Text        string  // 关键词，为空时只按条件筛选
+	CategoryIDs []int64 // 分类 (已展开子分类)，为空不限
+	MinPrice    int64   // 分，含；0 不限
+	MaxPrice    int64   // 分，含；0 不限
+	InStock     bool    // 只看有货
+	Sort        string  // 见 Sort* 常量
+	From        int
+	Size        int
+	Facets      bool               // 是否统计分类与价格区间
+	Highlight   *elastic.Highlight // 为空不高亮
+}
+
+// Hit 一条命中结果
+type Hit struct {
+	Doc
+	Highlight map[string][]string
+}
+
+// CategoryCount 分类分面
+type CategoryCount struct {
+	CategoryID int64
+	Count      int64
+}
+
+// BucketCount 价格区间分面
+type BucketCount struct {
+	PriceRange
+	Count int64
+}
+
+// Result 搜索结果
+type Result struct {
+	Total      int64
+	Hits       []Hit
+	Categories []CategoryCount // 按数量降序
+	Prices     []BucketCount   // 与 PriceRanges 一一对应
+}
+
+// categoryFilter 分类条件，无条件时返回 nil
+func (q *Query) categoryFilter() elastic.Query {
+	if len(q.CategoryIDs) == 0 {
+		return nil
+	}
+	terms := make([]interface{}, 0, len(q.CategoryIDs))
+	for _, id := range q.CategoryIDs {
+		terms = append(terms, id)
+	}
+	return elastic.NewTermsQuery("category_id", terms...)
+}
+
+// priceFilter 价格条件，无条件时返回 nil
+func (q *Query) priceFilter() elastic.Query {
+	if q.MinPrice <= 0 && q.MaxPrice <= 0 {
+		return nil
+	}
+	r := elastic.NewRangeQuery("price")
+	if q.MinPrice > 0 {
+		r = r.Gte(q.MinPrice)
+	}
+	if q.MaxPrice > 0 {
+		r = r.Lte(q.MaxPrice)
+	}
+	return r
+}
+
+// orMatchAll 条件为空时匹配全部
+func orMatchAll(q elastic.Query) elastic.Query {
+	if q == nil {
+		return elastic.NewMatchAllQuery()
+	}
+	return q
+}
+
+// Source 按分析选项 o 构建查询请求体
+//
+// 分类与价格条件放在 post_filter 中，只作用于命中结果，不影响聚合；
+// 分类分面只叠加价格条件，价格分面只叠加分类条件，这样切换某一项筛选时能看到其它选项的数量。
+func (q *Query) Source(o Options) *elastic.SearchSource {
+	base := elastic.NewBoolQuery().Filter(elastic.NewTermQuery("status", model.StatusOnSale))
+	if q.Text != "" {
+		match := elastic.NewMultiMatchQuery(q.Text, o.searchFields()...)
+		if o.Fuzzy {
+			match = match.Fuzziness("AUTO").PrefixLength(1)
+		}
+		base = base.Must(match)
+	}
+	if q.InStock {
+		base = base.Filter(elastic.NewTermQuery("in_stock", true))
+	}
+	src := elastic.NewSearchSource().Query(base).From(q.From).Size(q.Size).TrackTotalHits(true)
+
+	catFilter, priceFilter := q.categoryFilter(), q.priceFilter()
+	if catFilter != nil || priceFilter != nil {
+		post := elastic.NewBoolQuery()
+		if catFilter != nil {
+			post = post.Filter(catFilter)
+		}
+		if priceFilter != nil {
+			post = post.Filter(priceFilter)
+		}
+		src = src.PostFilter(post)
+	}
+
+	if q.Facets {
+		src = src.Aggregation("categories", elastic.NewFilterAggregation().Filter(orMatchAll(priceFilter)).
+			SubAggregation("ids", elastic.NewTermsAggregation().Field("category_id").Size(200)))
+		ranges := elastic.NewRangeAggregation().Field("price")
+		for _, r := range PriceRanges {
+			if r.To > 0 {
+				ranges = ranges.AddRange(r.From, r.To)
+			} else {
+				ranges = ranges.AddUnboundedTo(r.From)
+			}
+		}
+		src = src.Aggregation("prices", elastic.NewFilterAggregation().Filter(orMatchAll(catFilter)).
+			SubAggregation("ranges", ranges))
+	}
+
+	switch q.Sort {
+	case SortPriceAsc:
+		src = src.SortBy(elastic.NewFieldSort("price").Asc(), elastic.NewFieldSort("id").Desc())
+	case SortPriceDesc:
+		src = src.SortBy(elastic.NewFieldSort("price").Desc(), elastic.NewFieldSort("id").Desc())
+	case SortSales:
+		src = src.SortBy(elastic.NewFieldSort("sales").Desc(), elastic.NewFieldSort("id").Desc())
+	case SortRating:
+		src = src.SortBy(elastic.NewFieldSort("rating").Desc(), elastic.NewFieldSort("id").Desc())
+	default:
+		if q.Text == "" {
+			src = src.SortBy(elastic.NewFieldSort("id").Asc())
+		}
+	}
+
+	if q.Highlight != nil && q.Text != "" {
+		src = src.Highlight(q.Highlight)
+	}
+	return src
+}
+
+// Search 按条件搜索在售商品
+func (ix *Indexer) Search(ctx context.Context, q Query) (*Result, error) {
+	res, err := ix.cli.Search(ix.alias).SearchSource(q.Source(ix.Options())).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &Result{Total: res.TotalHits()}
+	for _, hit := range res.Hits.Hits {
+		var h Hit
+		if err := json.Unmarshal(hit.Source, &h.Doc); err != nil {
+			return nil, fmt.Errorf("解析文档 %s 失败: %w", hit.Id, err)
+		}
+		h.Highlight = hit.Highlight
+		out.Hits = append(out.Hits, h)
+	}
+	if !q.Facets {
+		return out, nil
+	}
+
+	if agg, ok := res.Aggregations.Filter("categories"); ok {
+		if terms, ok := agg.Aggregations.Terms("ids"); ok {
+			for _, b := range terms.Buckets {
+				id, err := b.KeyNumber.Int64()
+				if err != nil {
+					continue
+				}
+				out.Categories = append(out.Categories, CategoryCount{CategoryID: id, Count: b.DocCount})
+			}
+		}
+	}
+	if agg, ok := res.Aggregations.Filter("prices"); ok {
+		if ranges, ok := agg.Aggregations.Range("ranges"); ok {
+			// 区间按请求顺序返回
+			for i, b := range ranges.Buckets {
+				if i >= len(PriceRanges) {
+					break
+				}
+				out.Prices = append(out.Prices, BucketCount{PriceRange: PriceRanges[i], Count: b.DocCount})
+			}
+		}
+	}
+	return out, nil
+}
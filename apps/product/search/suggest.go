@@ -0,0 +1,43 @@
+package search
+
+import (
+	"context"
+	"math"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// Suggest 联想字段 (completion)，Weight 越大越靠前
+type Suggest struct {
+	Input  []string `json:"input"`
+	Weight int      `json:"weight,omitempty"`
+}
+
+// NewSuggest 以商品名为联想词，按销量加权
+func NewSuggest(name string, sales int64) *Suggest {
+	if sales > math.MaxInt32 {
+		sales = math.MaxInt32
+	}
+	if sales < 0 {
+		sales = 0
+	}
+	return &Suggest{Input: []string{name}, Weight: int(sales)}
+}
+
+// Suggest 前缀匹配的在售商品名，按销量排序并去重
+func (ix *Indexer) Suggest(ctx context.Context, prefix string, size int) ([]string, error) {
+	s := elastic.NewCompletionSuggester("name").Field("suggest").Prefix(prefix).Size(size).SkipDuplicates(true)
+	res, err := ix.cli.Search(ix.alias).
+		SearchSource(elastic.NewSearchSource().Suggester(s).FetchSource(false).Size(0)).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, entry := range res.Suggest["name"] {
+		for _, opt := range entry.Options {
+			out = append(out, opt.Text)
+		}
+	}
+	return out, nil
+}
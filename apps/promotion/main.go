@@ -0,0 +1,428 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-ecommerce/apps/promotion/model"
+	"go-ecommerce/pkg/config"
+	"go-ecommerce/pkg/database"
+	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/money"
+	"go-ecommerce/pkg/tracer"
+	"go-ecommerce/proto/promotion"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type server struct {
+	promotion.UnimplementedPromotionServiceServer
+	db *gorm.DB
+}
+
+func toCouponInfo(c *model.Coupon) *promotion.CouponInfo {
+	return &promotion.CouponInfo{
+		Id:             int64(c.ID),
+		Name:           c.Name,
+		Type:           c.Type,
+		Amount:         int64(c.Amount),
+		Percent:        int32(c.Percent),
+		MaxDiscount:    int64(c.MaxDiscount),
+		Threshold:      int64(c.Threshold),
+		Scope:          c.Scope,
+		ScopeIds:       c.ScopeIDList(),
+		TotalQuantity:  int32(c.TotalQuantity),
+		IssuedQuantity: int32(c.IssuedQuantity),
+		ClaimLimit:     int32(c.ClaimLimit),
+		UseLimit:       int32(c.UseLimit),
+		StartAt:        c.StartAt.Unix(),
+		EndAt:          c.EndAt.Unix(),
+		ValidDays:      int32(c.ValidDays),
+		Enabled:        c.Enabled,
+	}
+}
+
+func toUserCouponInfo(uc *model.UserCoupon, now time.Time) *promotion.UserCouponInfo {
+	st := uc.Status
+	if st == model.UserCouponUnused && now.After(uc.ValidUntil) {
+		st = model.UserCouponExpired
+	}
+	return &promotion.UserCouponInfo{
+		Id:         int64(uc.ID),
+		Coupon:     toCouponInfo(&uc.Coupon),
+		Status:     int32(st),
+		ClaimedAt:  uc.ClaimedAt.Unix(),
+		ValidUntil: uc.ValidUntil.Unix(),
+		OrderNo:    uc.OrderNo,
+	}
+}
+
+func toLines(in []*promotion.DiscountLine) []model.Line {
+	lines := make([]model.Line, 0, len(in))
+	for _, l := range in {
+		lines = append(lines, model.Line{SkuID: l.SkuId, CategoryID: l.CategoryId, Price: money.Cents(l.Price), Quantity: int(l.Quantity)})
+	}
+	return lines
+}
+
+// txError 事务中返回的 status 错误原样透传，其余视为内部错误
+func txError(err error, msg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, msg)
+}
+
+// SaveCoupon 创建 / 修改优惠券模板 (已发放数量不可修改)
+func (s *server) SaveCoupon(ctx context.Context, req *promotion.CouponInfo) (*promotion.SaveCouponResponse, error) {
+	ids := make([]string, 0, len(req.ScopeIds))
+	for _, id := range req.ScopeIds {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	c := model.Coupon{
+		ID:            uint(req.Id),
+		Name:          strings.TrimSpace(req.Name),
+		Type:          req.Type,
+		Amount:        money.Cents(req.Amount),
+		Percent:       int(req.Percent),
+		MaxDiscount:   money.Cents(req.MaxDiscount),
+		Threshold:     money.Cents(req.Threshold),
+		Scope:         req.Scope,
+		ScopeIDs:      strings.Join(ids, ","),
+		TotalQuantity: int(req.TotalQuantity),
+		ClaimLimit:    int(req.ClaimLimit),
+		UseLimit:      int(req.UseLimit),
+		StartAt:       time.Unix(req.StartAt, 0),
+		EndAt:         time.Unix(req.EndAt, 0),
+		ValidDays:     int(req.ValidDays),
+		Enabled:       req.Enabled,
+	}
+	if c.Scope == "" {
+		c.Scope = model.ScopeAll
+	}
+	if err := c.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if c.ID == 0 {
+		if err := s.db.WithContext(ctx).Create(&c).Error; err != nil {
+			return nil, status.Error(codes.Internal, "创建优惠券失败")
+		}
+		return &promotion.SaveCouponResponse{Id: int64(c.ID)}, nil
+	}
+	res := s.db.WithContext(ctx).Model(&c).Select("*").Omit("id", "issued_quantity", "created_at").Updates(&c)
+	if res.Error != nil {
+		return nil, status.Error(codes.Internal, "保存优惠券失败")
+	}
+	if res.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "优惠券不存在")
+	}
+	return &promotion.SaveCouponResponse{Id: int64(c.ID)}, nil
+}
+
+// ListCoupons 优惠券模板列表
+func (s *server) ListCoupons(ctx context.Context, req *promotion.ListCouponsRequest) (*promotion.ListCouponsResponse, error) {
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	q := s.db.WithContext(ctx).Model(&model.Coupon{})
+	if req.ClaimableOnly {
+		now := time.Now()
+		q = q.Where("enabled = ? AND start_at <= ? AND end_at > ?", true, now, now).
+			Where("total_quantity = 0 OR issued_quantity < total_quantity")
+	}
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询优惠券失败")
+	}
+	var coupons []model.Coupon
+	if err := q.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&coupons).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询优惠券失败")
+	}
+
+	resp := &promotion.ListCouponsResponse{Total: total}
+	for i := range coupons {
+		resp.Coupons = append(resp.Coupons, toCouponInfo(&coupons[i]))
+	}
+	return resp, nil
+}
+
+// ClaimCoupon 领取优惠券：锁定模板行，校验领取时间、发行总量与每人限领
+func (s *server) ClaimCoupon(ctx context.Context, req *promotion.ClaimCouponRequest) (*promotion.UserCouponInfo, error) {
+	if req.UserId <= 0 || req.CouponId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	now := time.Now()
+	var uc model.UserCoupon
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var c model.Coupon
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&c, req.CouponId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "优惠券不存在")
+			}
+			return err
+		}
+		if !c.Enabled || now.Before(c.StartAt) || !now.Before(c.EndAt) {
+			return status.Error(codes.FailedPrecondition, "优惠券不在领取时间内")
+		}
+		if c.TotalQuantity > 0 && c.IssuedQuantity >= c.TotalQuantity {
+			return status.Error(codes.FailedPrecondition, "优惠券已领完")
+		}
+		if c.ClaimLimit > 0 {
+			var claimed int64
+			if err := tx.Model(&model.UserCoupon{}).Where("user_id = ? AND coupon_id = ?", req.UserId, c.ID).Count(&claimed).Error; err != nil {
+				return err
+			}
+			if claimed >= int64(c.ClaimLimit) {
+				return status.Errorf(codes.FailedPrecondition, "该优惠券每人限领 %d 张", c.ClaimLimit)
+			}
+		}
+
+		uc = model.UserCoupon{
+			UserID:     req.UserId,
+			CouponID:   c.ID,
+			Status:     model.UserCouponUnused,
+			ClaimedAt:  now,
+			ValidUntil: c.ValidUntil(now),
+		}
+		if err := tx.Omit("Coupon").Create(&uc).Error; err != nil {
+			return err
+		}
+		uc.Coupon = c
+		return tx.Model(&c).UpdateColumn("issued_quantity", gorm.Expr("issued_quantity + 1")).Error
+	})
+	if err != nil {
+		return nil, txError(err, "领取优惠券失败")
+	}
+	return toUserCouponInfo(&uc, now), nil
+}
+
+// ListUserCoupons 用户的优惠券，未使用的在前
+func (s *server) ListUserCoupons(ctx context.Context, req *promotion.ListUserCouponsRequest) (*promotion.ListUserCouponsResponse, error) {
+	now := time.Now()
+	q := s.db.WithContext(ctx).Preload("Coupon").Where("user_id = ?", req.UserId)
+	switch req.Status {
+	case -1:
+	case model.UserCouponUnused:
+		q = q.Where("status = ? AND valid_until >= ?", model.UserCouponUnused, now)
+	case model.UserCouponExpired:
+		q = q.Where("status = ? AND valid_until < ?", model.UserCouponUnused, now)
+	default:
+		q = q.Where("status = ?", req.Status)
+	}
+	var list []model.UserCoupon
+	if err := q.Order("status ASC, valid_until ASC").Find(&list).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询优惠券失败")
+	}
+	resp := &promotion.ListUserCouponsResponse{}
+	for i := range list {
+		resp.Coupons = append(resp.Coupons, toUserCouponInfo(&list[i], now))
+	}
+	return resp, nil
+}
+
+// checkUsable 校验用户优惠券可用于新订单：未使用、在有效期内且未超过每人限用次数
+func checkUsable(tx *gorm.DB, uc *model.UserCoupon, now time.Time) error {
+	if uc.Status != model.UserCouponUnused {
+		return status.Error(codes.FailedPrecondition, "优惠券已使用")
+	}
+	if now.Before(uc.Coupon.StartAt) || now.After(uc.ValidUntil) {
+		return status.Error(codes.FailedPrecondition, "优惠券不在有效期内")
+	}
+	if uc.Coupon.UseLimit > 0 {
+		var used int64
+		err := tx.Model(&model.UserCoupon{}).
+			Where("user_id = ? AND coupon_id = ? AND status IN ?", uc.UserID, uc.CouponID, []int{model.UserCouponLocked, model.UserCouponUsed}).
+			Count(&used).Error
+		if err != nil {
+			return err
+		}
+		if used >= int64(uc.Coupon.UseLimit) {
+			return status.Errorf(codes.FailedPrecondition, "该优惠券每人限用 %d 次", uc.Coupon.UseLimit)
+		}
+	}
+	return nil
+}
+
+// discount 计算优惠金额，门槛或适用范围不满足时返回 FailedPrecondition
+func discount(uc *model.UserCoupon, lines []*promotion.DiscountLine) (*promotion.DiscountResult, error) {
+	total, splits, err := uc.Coupon.Discount(toLines(lines))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	res := &promotion.DiscountResult{
+		UserCouponId: int64(uc.ID),
+		CouponId:     int64(uc.CouponID),
+		Name:         uc.Coupon.Name,
+		Amount:       int64(total),
+	}
+	for _, l := range splits {
+		res.Lines = append(res.Lines, &promotion.LineDiscount{SkuId: l.SkuID, Amount: int64(l.Amount)})
+	}
+	return res, nil
+}
+
+func (s *server) loadUserCoupon(tx *gorm.DB, userId, userCouponId int64) (*model.UserCoupon, error) {
+	var uc model.UserCoupon
+	err := tx.Preload("Coupon").Where("id = ? AND user_id = ?", userCouponId, userId).First(&uc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "优惠券不存在")
+	}
+	return &uc, err
+}
+
+// CalculateDiscount 试算优惠，不占用优惠券
+func (s *server) CalculateDiscount(ctx context.Context, req *promotion.CalculateDiscountRequest) (*promotion.DiscountResult, error) {
+	db := s.db.WithContext(ctx)
+	uc, err := s.loadUserCoupon(db, req.UserId, req.UserCouponId)
+	if err != nil {
+		return nil, txError(err, "查询优惠券失败")
+	}
+	if err := checkUsable(db, uc, time.Now()); err != nil {
+		return nil, txError(err, "查询优惠券失败")
+	}
+	return discount(uc, req.Lines)
+}
+
+// LockCoupon 下单时锁定优惠券；同一订单重复调用 (重试) 返回相同结果
+func (s *server) LockCoupon(ctx context.Context, req *promotion.LockCouponRequest) (*promotion.DiscountResult, error) {
+	if req.OrderNo == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	now := time.Now()
+	var res *promotion.DiscountResult
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		uc, err := s.loadUserCoupon(tx, req.UserId, req.UserCouponId)
+		if err != nil {
+			return err
+		}
+		// 按 id 顺序锁定该用户同一模板下的全部优惠券：用不同的券并发下单时，
+		// 每人限用次数的计数必须串行，否则两笔订单都会看到未超限
+		var ids []uint
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&model.UserCoupon{}).
+			Where("user_id = ? AND coupon_id = ?", uc.UserID, uc.CouponID).Order("id").Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+		// 加锁后重新读取，后续校验都用锁定读，不受事务快照影响
+		if uc, err = s.loadUserCoupon(tx.Clauses(clause.Locking{Strength: "UPDATE"}), req.UserId, req.UserCouponId); err != nil {
+			return err
+		}
+		if uc.Status == model.UserCouponLocked && uc.OrderNo == req.OrderNo {
+			res, err = discount(uc, req.Lines)
+			return err
+		}
+		if err := checkUsable(tx.Clauses(clause.Locking{Strength: "UPDATE"}), uc, now); err != nil {
+			return err
+		}
+		if res, err = discount(uc, req.Lines); err != nil {
+			return err
+		}
+		return tx.Model(uc).Omit("Coupon").Updates(map[string]interface{}{
+			"status":          model.UserCouponLocked,
+			"order_no":        req.OrderNo,
+			"discount_amount": money.Cents(res.Amount),
+			"locked_at":       now,
+		}).Error
+	})
+	if err != nil {
+		return nil, txError(err, "锁定优惠券失败")
+	}
+	return res, nil
+}
+
+// ReleaseCoupon 释放订单锁定的优惠券
+func (s *server) ReleaseCoupon(ctx context.Context, req *promotion.OrderCouponRequest) (*promotion.OrderCouponResponse, error) {
+	if req.OrderNo == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	res := s.db.WithContext(ctx).Model(&model.UserCoupon{}).
+		Where("order_no = ? AND status = ?", req.OrderNo, model.UserCouponLocked).
+		Updates(map[string]interface{}{"status": model.UserCouponUnused, "order_no": "", "discount_amount": 0, "locked_at": nil})
+	if res.Error != nil {
+		return nil, status.Error(codes.Internal, "释放优惠券失败")
+	}
+	return &promotion.OrderCouponResponse{Affected: int32(res.RowsAffected)}, nil
+}
+
+// RedeemCoupon 订单支付后核销
+func (s *server) RedeemCoupon(ctx context.Context, req *promotion.OrderCouponRequest) (*promotion.OrderCouponResponse, error) {
+	if req.OrderNo == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	res := s.db.WithContext(ctx).Model(&model.UserCoupon{}).
+		Where("order_no = ? AND status = ?", req.OrderNo, model.UserCouponLocked).
+		Updates(map[string]interface{}{"status": model.UserCouponUsed, "used_at": time.Now()})
+	if res.Error != nil {
+		return nil, status.Error(codes.Internal, "核销优惠券失败")
+	}
+	return &promotion.OrderCouponResponse{Affected: int32(res.RowsAffected)}, nil
+}
+
+func main() {
+	jaegerAddr := "jaeger:4318"
+	if os.Getenv("JAEGER_HOST") != "" {
+		jaegerAddr = os.Getenv("JAEGER_HOST")
+	}
+	tp, err := tracer.InitTracer("promotion-service", jaegerAddr)
+	if err != nil {
+		log.Printf("Init tracer failed: %v", err)
+	}
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	c, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("加载配置失败: %v", err)
+	}
+
+	if v := os.Getenv("MYSQL_HOST"); v != "" {
+		c.Mysql.Host = v
+	}
+	if v := os.Getenv("MYSQL_PORT"); v != "" {
+		if p, err := strconv.Atoi(v); err == nil {
+			c.Mysql.Port = p
+		}
+	}
+	if v := os.Getenv("CONSUL_ADDRESS"); v != "" {
+		c.Consul.Address = v
+	}
+
+	db, err := database.InitMySQL(c.Mysql)
+	if err != nil {
+		log.Fatalf("初始化 MySQL 失败: %v", err)
+	}
+	db.AutoMigrate(&model.Coupon{}, &model.UserCoupon{})
+
+	s := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	promotion.RegisterPromotionServiceServer(s, &server{db: db})
+	reflection.Register(s)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", c.Service.Port))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	if err := discovery.RegisterService(c.Service.Name, c.Service.Port, c.Consul.Address); err != nil {
+		log.Fatalf("Failed to register service: %v", err)
+	}
+
+	log.Printf("Promotion Service listening on :%d", c.Service.Port)
+	s.Serve(lis)
+}
@@ -0,0 +1,209 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-ecommerce/pkg/money"
+)
+
+// 优惠券类型
+const (
+	CouponFixed   = "fixed"   // 满减：减免固定金额
+	CouponPercent = "percent" // 折扣：按百分比优惠
+)
+
+// 适用范围
+const (
+	ScopeAll      = "all"      // 全场
+	ScopeCategory = "category" // 指定分类
+	ScopeSku      = "sku"      // 指定商品 (SKU)
+)
+
+// 用户优惠券状态
+const (
+	UserCouponUnused  = 0
+	UserCouponLocked  = 1 // 已用于下单，订单未支付
+	UserCouponUsed    = 2
+	UserCouponExpired = 3 // 仅用于展示，不落库
+)
+
+// Coupon 优惠券模板
+type Coupon struct {
+	ID             uint        `gorm:"primaryKey"`
+	Name           string      `gorm:"type:varchar(64)"`
+	Type           string      `gorm:"type:varchar(16)"`
+	Amount         money.Cents `gorm:"type:decimal(10,2)"` // fixed: 减免金额
+	Percent        int         `gorm:"type:int"`           // percent: 85 表示 85 折
+	MaxDiscount    money.Cents `gorm:"type:decimal(10,2)"` // percent: 最多优惠金额，0 表示不限
+	Threshold      money.Cents `gorm:"type:decimal(10,2)"` // 适用商品金额满 X 可用，0 表示无门槛
+	Scope          string      `gorm:"type:varchar(16);default:'all'"`
+	ScopeIDs       string      `gorm:"type:varchar(1024)"` // 分类或 SKU ID，逗号分隔
+	TotalQuantity  int         `gorm:"type:int"`           // 发行总量，0 表示不限
+	IssuedQuantity int         `gorm:"type:int;default:0"`
+	ClaimLimit     int         `gorm:"type:int"` // 每人限领，0 表示不限
+	UseLimit       int         `gorm:"type:int"` // 每人限用，0 表示不限
+	StartAt        time.Time
+	EndAt          time.Time
+	ValidDays      int  `gorm:"type:int"` // 领取后 N 天内有效，0 表示到 EndAt 为止
+	Enabled        bool // 不设 gorm 默认值，否则创建时 false 会被当作零值忽略而写入默认的 true
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// UserCoupon 用户领取的优惠券，每张只能用于一个订单
+type UserCoupon struct {
+	ID             uint        `gorm:"primaryKey"`
+	UserID         int64       `gorm:"index"`
+	CouponID       uint        `gorm:"index"`
+	Coupon         Coupon      `gorm:"foreignKey:CouponID"`
+	Status         int         `gorm:"default:0"`
+	OrderNo        string      `gorm:"type:varchar(64);index"`
+	DiscountAmount money.Cents `gorm:"type:decimal(10,2)"` // 锁定时的优惠金额
+	ClaimedAt      time.Time
+	ValidUntil     time.Time
+	LockedAt       *time.Time
+	UsedAt         *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Line 参与计算优惠的商品行
+type Line struct {
+	SkuID      int64
+	CategoryID int64
+	Price      money.Cents
+	Quantity   int
+}
+
+// LineDiscount 分摊到商品行的优惠金额
+type LineDiscount struct {
+	SkuID  int64
+	Amount money.Cents
+}
+
+// ErrNoEligibleItems 订单中没有适用该优惠券的商品
+var ErrNoEligibleItems = errors.New("订单中没有适用该优惠券的商品")
+
+// ScopeIDList 拆分适用范围 ID
+func (c *Coupon) ScopeIDList() []int64 {
+	var out []int64
+	for _, v := range strings.Split(c.ScopeIDs, ",") {
+		if id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// Validate 校验模板配置
+func (c *Coupon) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("优惠券名称不能为空")
+	}
+	switch c.Type {
+	case CouponFixed:
+		if c.Amount <= 0 {
+			return errors.New("减免金额必须大于 0")
+		}
+	case CouponPercent:
+		if c.Percent <= 0 || c.Percent >= 100 {
+			return errors.New("折扣必须在 1 到 99 之间")
+		}
+	default:
+		return fmt.Errorf("不支持的优惠券类型: %s", c.Type)
+	}
+	switch c.Scope {
+	case ScopeAll:
+	case ScopeCategory, ScopeSku:
+		if len(c.ScopeIDList()) == 0 {
+			return errors.New("请指定适用的分类或商品")
+		}
+	default:
+		return fmt.Errorf("不支持的适用范围: %s", c.Scope)
+	}
+	if c.Threshold < 0 || c.MaxDiscount < 0 {
+		return errors.New("金额不能为负数")
+	}
+	if !c.EndAt.After(c.StartAt) {
+		return errors.New("结束时间必须晚于开始时间")
+	}
+	return nil
+}
+
+// ValidUntil 领取时计算用户优惠券的过期时间
+func (c *Coupon) ValidUntil(claimedAt time.Time) time.Time {
+	if c.ValidDays > 0 {
+		if t := claimedAt.AddDate(0, 0, c.ValidDays); t.Before(c.EndAt) {
+			return t
+		}
+	}
+	return c.EndAt
+}
+
+// Applies 商品行是否在适用范围内
+func (c *Coupon) Applies(l Line) bool {
+	switch c.Scope {
+	case ScopeCategory:
+		return containsID(c.ScopeIDList(), l.CategoryID)
+	case ScopeSku:
+		return containsID(c.ScopeIDList(), l.SkuID)
+	default:
+		return true
+	}
+}
+
+// Discount 计算优惠金额并按适用商品金额比例分摊到商品行
+// 折扣券优惠额按 money.MulRatio 四舍五入到分，分摊规则见 money.Allocate，分摊之和等于优惠总额
+func (c *Coupon) Discount(lines []Line) (money.Cents, []LineDiscount, error) {
+	var eligible []Line
+	var weights []money.Cents
+	var base money.Cents // 适用商品金额
+	for _, l := range lines {
+		if l.Quantity > 0 && c.Applies(l) {
+			amount := l.Price.Mul(int64(l.Quantity))
+			eligible = append(eligible, l)
+			weights = append(weights, amount)
+			base += amount
+		}
+	}
+	if len(eligible) == 0 || base == 0 {
+		return 0, nil, ErrNoEligibleItems
+	}
+	if c.Threshold > 0 && base < c.Threshold {
+		return 0, nil, fmt.Errorf("适用商品金额未满 %s 元", c.Threshold)
+	}
+
+	var total money.Cents
+	switch c.Type {
+	case CouponFixed:
+		total = c.Amount
+	case CouponPercent:
+		total = base.MulRatio(int64(100-c.Percent), 100)
+		if c.MaxDiscount > 0 && total > c.MaxDiscount {
+			total = c.MaxDiscount
+		}
+	}
+	if total > base {
+		total = base
+	}
+
+	shares := money.Allocate(total, weights)
+	splits := make([]LineDiscount, 0, len(eligible))
+	for i, l := range eligible {
+		splits = append(splits, LineDiscount{SkuID: l.SkuID, Amount: shares[i]})
+	}
+	return total, splits, nil
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go-ecommerce/pkg/money"
+)
+
+func TestCouponDiscount(t *testing.T) {
+	lines := []Line{
+		{SkuID: 1, CategoryID: 10, Price: 1000, Quantity: 2}, // 20.00
+		{SkuID: 2, CategoryID: 20, Price: 3000, Quantity: 1}, // 30.00
+		{SkuID: 3, CategoryID: 10, Price: 500, Quantity: 0},  // 数量为 0 不参与
+	}
+	cases := []struct {
+		name    string
+		coupon  Coupon
+		lines   []Line
+		want    money.Cents
+		splits  map[int64]money.Cents
+		wantErr bool
+	}{
+		{
+			name:   "满减按金额比例分摊",
+			coupon: Coupon{Type: CouponFixed, Amount: 1000, Threshold: 5000},
+			lines:  lines,
+			want:   1000,
+			splits: map[int64]money.Cents{1: 400, 2: 600},
+		},
+		{
+			name:    "未满门槛",
+			coupon:  Coupon{Type: CouponFixed, Amount: 1000, Threshold: 5001},
+			lines:   lines,
+			wantErr: true,
+		},
+		{
+			name:   "减免金额不超过商品金额",
+			coupon: Coupon{Type: CouponFixed, Amount: 9000},
+			lines:  lines,
+			want:   5000,
+			splits: map[int64]money.Cents{1: 2000, 2: 3000},
+		},
+		{
+			// 9.99 元打 85 折优惠 1.4985 元，四舍五入为 1.50
+			name:   "折扣四舍五入进位",
+			coupon: Coupon{Type: CouponPercent, Percent: 85},
+			lines:  []Line{{SkuID: 1, Price: 999, Quantity: 1}},
+			want:   150,
+			splits: map[int64]money.Cents{1: 150},
+		},
+		{
+			// 10.03 元打 85 折优惠 1.5045 元，四舍五入为 1.50
+			name:   "折扣四舍五入舍去",
+			coupon: Coupon{Type: CouponPercent, Percent: 85},
+			lines:  []Line{{SkuID: 1, Price: 1003, Quantity: 1}},
+			want:   150,
+			splits: map[int64]money.Cents{1: 150},
+		},
+		{
+			name:   "折扣封顶",
+			coupon: Coupon{Type: CouponPercent, Percent: 50, MaxDiscount: 1000},
+			lines:  lines,
+			want:   1000,
+			splits: map[int64]money.Cents{1: 400, 2: 600},
+		},
+		{
+			name:   "折扣未达封顶",
+			coupon: Coupon{Type: CouponPercent, Percent: 90, MaxDiscount: 1000},
+			lines:  lines,
+			want:   500,
+			splits: map[int64]money.Cents{1: 200, 2: 300},
+		},
+		{
+			name:   "指定分类只计算适用商品",
+			coupon: Coupon{Type: CouponPercent, Percent: 80, Scope: ScopeCategory, ScopeIDs: "10, 30"},
+			lines:  lines,
+			want:   400,
+			splits: map[int64]money.Cents{1: 400},
+		},
+		{
+			name:    "指定分类的门槛按适用商品金额计算",
+			coupon:  Coupon{Type: CouponFixed, Amount: 100, Threshold: 3000, Scope: ScopeCategory, ScopeIDs: "10"},
+			lines:   lines,
+			wantErr: true,
+		},
+		{
+			name:   "指定商品",
+			coupon: Coupon{Type: CouponFixed, Amount: 500, Scope: ScopeSku, ScopeIDs: "2"},
+			lines:  lines,
+			want:   500,
+			splits: map[int64]money.Cents{2: 500},
+		},
+		{
+			// 1.00 元按 1:2 分摊为 0.33 / 0.67，余下的 1 分给余数较大的一行
+			name:   "分摊余数",
+			coupon: Coupon{Type: CouponFixed, Amount: 100},
+			lines:  []Line{{SkuID: 1, Price: 100, Quantity: 1}, {SkuID: 2, Price: 200, Quantity: 1}},
+			want:   100,
+			splits: map[int64]money.Cents{1: 33, 2: 67},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			total, splits, err := c.coupon.Discount(c.lines)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际优惠 %s", total)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != c.want {
+				t.Fatalf("期望优惠 %s，实际 %s", c.want, total)
+			}
+			var sum money.Cents
+			got := make(map[int64]money.Cents, len(splits))
+			for _, s := range splits {
+				got[s.SkuID] = s.Amount
+				sum += s.Amount
+			}
+			if sum != total {
+				t.Fatalf("分摊之和 %s 不等于优惠总额 %s", sum, total)
+			}
+			if len(got) != len(c.splits) {
+				t.Fatalf("期望分摊 %v，实际 %v", c.splits, got)
+			}
+			for sku, amount := range c.splits {
+				if got[sku] != amount {
+					t.Fatalf("期望分摊 %v，实际 %v", c.splits, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCouponDiscountNoEligibleItems(t *testing.T) {
+	c := Coupon{Type: CouponFixed, Amount: 100, Scope: ScopeSku, ScopeIDs: "9"}
+	_, _, err := c.Discount([]Line{{SkuID: 1, Price: 1000, Quantity: 1}})
+	if !errors.Is(err, ErrNoEligibleItems) {
+		t.Fatalf("期望 ErrNoEligibleItems，实际 %v", err)
+	}
+}
+
+func TestCouponValidate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)
+	valid := func() Coupon {
+		return Coupon{Name: "满 50 减 10", Type: CouponFixed, Amount: 1000, Scope: ScopeAll, StartAt: start, EndAt: start.AddDate(0, 1, 0)}
+	}
+	cases := []struct {
+		name    string
+		modify  func(c *Coupon)
+		wantErr bool
+	}{
+		{"满减券", func(c *Coupon) {}, false},
+		{"折扣券", func(c *Coupon) { c.Type, c.Amount, c.Percent = CouponPercent, 0, 85 }, false},
+		{"名称为空", func(c *Coupon) { c.Name = "  " }, true},
+		{"减免金额为 0", func(c *Coupon) { c.Amount = 0 }, true},
+		{"折扣为 0", func(c *Coupon) { c.Type, c.Percent = CouponPercent, 0 }, true},
+		{"折扣为 100", func(c *Coupon) { c.Type, c.Percent = CouponPercent, 100 }, true},
+		{"未知类型", func(c *Coupon) { c.Type = "gift" }, true},
+		{"指定分类", func(c *Coupon) { c.Scope, c.ScopeIDs = ScopeCategory, "1,2" }, false},
+		{"指定分类但未给出 ID", func(c *Coupon) { c.Scope, c.ScopeIDs = ScopeCategory, " , " }, true},
+		{"指定商品但未给出 ID", func(c *Coupon) { c.Scope = ScopeSku }, true},
+		{"未知范围", func(c *Coupon) { c.Scope = "brand" }, true},
+		{"门槛为负数", func(c *Coupon) { c.Threshold = -1 }, true},
+		{"封顶为负数", func(c *Coupon) { c.MaxDiscount = -1 }, true},
+		{"结束时间等于开始时间", func(c *Coupon) { c.EndAt = c.StartAt }, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := valid()
+			tc.modify(&c)
+			err := c.Validate()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("wantErr=%v，实际 %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCouponValidUntil(t *testing.T) {
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local)
+	claimed := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	cases := []struct {
+		name      string
+		validDays int
+		want      time.Time
+	}{
+		{"不限天数到活动结束", 0, end},
+		{"领取后 7 天", 7, claimed.AddDate(0, 0, 7)},
+		{"不超过活动结束时间", 60, end},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Coupon{EndAt: end, ValidDays: tc.validDays}
+			if got := c.ValidUntil(claimed); !got.Equal(tc.want) {
+				t.Fatalf("期望 %v，实际 %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"go-ecommerce/pkg/discovery"
 	"go-ecommerce/pkg/tracer"
 	"go-ecommerce/proto/order"
+	"go-ecommerce/proto/product"
 	"go-ecommerce/proto/review"
 
 	_ "github.com/mbobakov/grpc-consul-resolver"
@@ -47,8 +48,9 @@ type Review struct {
 
 type server struct {
 	review.UnimplementedReviewServiceServer
-	db          *gorm.DB
-	orderClient order.OrderServiceClient // 增加订单客户端引用
+	db            *gorm.DB
+	orderClient   order.OrderServiceClient // 增加订单客户端引用
+	productClient product.ProductServiceClient
 }
 
 // CreateReview 创建评价
@@ -84,6 +86,14 @@ func (s *server) CreateReview(ctx context.Context, req *review.CreateReviewReque
 		log.Printf("[Critical] 调用 OrderService 失败: %v", err)
 	}
 
+	// 累计商品评分，用于搜索按评分排序；失败不影响评价结果
+	if _, err := s.productClient.RecordRating(ctx, &product.RecordRatingRequest{
+		ProductId: req.ProductId,
+		Star:      req.Star,
+	}); err != nil {
+		log.Printf("[Review] 记录商品 %d 评分失败: %v", req.ProductId, err)
+	}
+
 	return &review.CreateReviewResponse{ReviewId: rev.ID}, nil
 }
 
@@ -149,6 +159,71 @@ func (s *server) CheckReviewStatus(ctx context.Context, req *review.CheckReviewS
 	}, nil
 }
 
+// ListUserReviews 获取用户发表的全部评价
+func (s *server) ListUserReviews(ctx context.Context, req *review.ListUserReviewsRequest) (*review.ListUserReviewsResponse, error) {
+	var reviews []Review
+	if err := s.db.Where("user_id = ?", req.UserId).Order("created_at desc").Find(&reviews).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询数据库失败")
+	}
+
+	var pbReviews []*review.ReviewInfo
+	for _, r := range reviews {
+		var imgs []string
+		_ = json.Unmarshal([]byte(r.Images), &imgs)
+		pbReviews = append(pbReviews, &review.ReviewInfo{
+			Id:           r.ID,
+			UserId:       r.UserID,
+			UserNickname: r.UserNickname,
+			UserAvatar:   r.UserAvatar,
+			Content:      r.Content,
+			Star:         r.Star,
+			Images:       imgs,
+			CreatedAt:    r.CreatedAt.Format("2006-01-02 15:04:05"),
+			SkuName:      r.SkuName,
+			OrderNo:      r.OrderNo,
+			ProductId:    r.ProductID,
+		})
+	}
+	return &review.ListUserReviewsResponse{Reviews: pbReviews}, nil
+}
+
+// AnonymizeUserReviews 注销账号：评价保留 (商品评分不受影响)，用户身份改为匿名
+func (s *server) AnonymizeUserReviews(ctx context.Context, req *review.AnonymizeUserReviewsRequest) (*review.AnonymizeUserReviewsResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+	result := s.db.Model(&Review{}).Where("user_id = ?", req.UserId).Updates(map[string]interface{}{
+		"user_nickname": "已注销用户",
+		"user_avatar":   "",
+		"is_anonymous":  true,
+	})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "更新数据库失败")
+	}
+	log.Printf("[Review] 用户 %d 注销，已匿名化 %d 条评价", req.UserId, result.RowsAffected)
+	return &review.AnonymizeUserReviewsResponse{Anonymized: result.RowsAffected}, nil
+}
+
+// ListProductRatings 按商品汇总评价星级，供商品服务回填评分
+func (s *server) ListProductRatings(ctx context.Context, req *review.ListProductRatingsRequest) (*review.ListProductRatingsResponse, error) {
+	var rows []struct {
+		ProductID int64
+		StarSum   int64
+		Cnt       int64
+	}
+	err := s.db.WithContext(ctx).Model(&Review{}).
+		Select("product_id, SUM(star) AS star_sum, COUNT(*) AS cnt").
+		Group("product_id").Scan(&rows).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询评价失败")
+	}
+	resp := &review.ListProductRatingsResponse{}
+	for _, r := range rows {
+		resp.Ratings = append(resp.Ratings, &review.ProductRating{ProductId: r.ProductID, StarSum: r.StarSum, Count: r.Cnt})
+	}
+	return resp, nil
+}
+
 func main() {
 	jaegerAddr := "jaeger:4318"
 	if os.Getenv("JAEGER_HOST") != "" {
@@ -188,11 +263,16 @@ func main() {
 	if err != nil {
 		log.Fatalf("连接订单服务失败: %v", err)
 	}
+	productConn, err := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "product-service"), opts...)
+	if err != nil {
+		log.Fatalf("连接商品服务失败: %v", err)
+	}
 
 	s := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	review.RegisterReviewServiceServer(s, &server{
-		db:          db,
-		orderClient: order.NewOrderServiceClient(orderConn),
+		db:            db,
+		orderClient:   order.NewOrderServiceClient(orderConn),
+		productClient: product.NewProductServiceClient(productConn),
 	})
 	reflection.Register(s)
 
@@ -2,6 +2,11 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -12,18 +17,27 @@ import (
 	"time"
 
 	"go-ecommerce/apps/user/model"
+	"go-ecommerce/apps/user/oauth"
 	"go-ecommerce/pkg/config"
 	"go-ecommerce/pkg/database"
 	"go-ecommerce/pkg/discovery"
+	"go-ecommerce/pkg/notify"
+	"go-ecommerce/pkg/utils"
+	"go-ecommerce/proto/address"
+	"go-ecommerce/proto/cart"
+	"go-ecommerce/proto/order"
+	"go-ecommerce/proto/review"
 	"go-ecommerce/proto/user"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
+	_ "github.com/mbobakov/grpc-consul-resolver"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // 必须与 Gateway 保持一致
@@ -31,9 +45,28 @@ var jwtSecret = []byte("my_secret_key")
 
 type server struct {
 	user.UnimplementedUserServiceServer
-	db *gorm.DB
+	db       *gorm.DB
+	policy   utils.PasswordPolicy
+	sender   notify.Sender
+	resetTTL time.Duration
+
+	// 第三方登录
+	providers map[string]*oauth.Provider
+	stateCdc  *oauth.StateCodec
+
+	// 个人数据导出/注销账号需要汇总的下游服务
+	addressClient address.AddressServiceClient
+	orderClient   order.OrderServiceClient
+	reviewClient  review.ReviewServiceClient
+	cartClient    cart.CartServiceClient
 }
 
+// 个人数据导出归档有效期
+const dataExportTTL = 7 * 24 * time.Hour
+
+// 无密码账号注销确认令牌有效期
+const deletionConfirmTTL = 10 * time.Minute
+
 func (s *server) Register(ctx context.Context, req *user.RegisterRequest) (*user.RegisterResponse, error) {
 	var cnt int64
 	s.db.Model(&model.User{}).Where("username = ?", req.Username).Count(&cnt)
@@ -41,15 +74,20 @@ func (s *server) Register(ctx context.Context, req *user.RegisterRequest) (*user
 		return nil, status.Error(codes.AlreadyExists, "Username already exists")
 	}
 
+	// 密码强度校验
+	if err := s.policy.Validate(req.Password); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// 密码加密存储
-	hashedPwd, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPwd, err := utils.HashPassword(req.Password)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to encrypt password")
 	}
 
 	u := model.User{
 		Username: req.Username,
-		Password: string(hashedPwd),
+		Password: hashedPwd,
 		Mobile:   req.Mobile,
 		Nickname: req.Nickname,
 	}
@@ -69,19 +107,12 @@ func (s *server) Login(ctx context.Context, req *user.LoginRequest) (*user.Login
 	}
 
 	// 2. 密码比对逻辑
-	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password))
-	if err != nil {
+	if !utils.CheckPassword(req.Password, u.Password) {
 		return nil, status.Error(codes.Unauthenticated, "Invalid password")
 	}
 
 	// 3. 生成 JWT
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": u.ID,
-		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(),
-		"iss":     "go-ecommerce",
-	})
-
-	tokenString, err := token.SignedString(jwtSecret)
+	tokenString, err := issueToken(u.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to generate token")
 	}
@@ -93,6 +124,16 @@ func (s *server) Login(ctx context.Context, req *user.LoginRequest) (*user.Login
 	}, nil
 }
 
+// issueToken 签发登录 JWT (密码登录与第三方登录共用)
+func issueToken(userID uint) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(),
+		"iss":     "go-ecommerce",
+	})
+	return token.SignedString(jwtSecret)
+}
+
 // GetUserInfo 获取用户信息
 func (s *server) GetUserInfo(ctx context.Context, req *user.GetUserInfoRequest) (*user.GetUserInfoResponse, error) {
 	var u model.User
@@ -164,26 +205,587 @@ func (s *server) UpdatePassword(ctx context.Context, req *user.UpdatePasswordReq
 		return nil, status.Error(codes.NotFound, "用户不存在")
 	}
 
-	// 1. 验证旧密码
-	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.OldPassword))
-	if err != nil {
+	// 1. 验证旧密码 (第三方登录自动注册的用户尚未设置密码，首次设置无需旧密码)
+	if u.Password != "" && !utils.CheckPassword(req.OldPassword, u.Password) {
 		return nil, status.Error(codes.InvalidArgument, "旧密码错误")
 	}
 
-	// 2. 加密新密码
-	hashedPwd, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	// 2. 新密码强度校验
+	if err := s.policy.Validate(req.NewPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 3. 加密新密码
+	hashedPwd, err := utils.HashPassword(req.NewPassword)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "加密失败")
 	}
 
-	// 3. 更新数据库
-	if err := s.db.Model(&u).Update("password", string(hashedPwd)).Error; err != nil {
+	// 4. 更新数据库
+	if err := s.db.Model(&u).Update("password", hashedPwd).Error; err != nil {
 		return nil, status.Error(codes.Internal, "数据库更新失败")
 	}
 
 	return &user.UpdatePasswordResponse{Success: true}, nil
 }
 
+// RequestPasswordReset 申请找回密码
+// 生成一次性令牌并通过 Sender 投递到用户绑定的手机号；
+// 用户不存在时同样返回成功，避免被用来探测用户名
+func (s *server) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetRequest) (*user.RequestPasswordResetResponse, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "用户名不能为空")
+	}
+
+	var u model.User
+	if err := s.db.Where("username = ?", req.Username).First(&u).Error; err != nil {
+		log.Printf("[User] 找回密码: 用户 %s 不存在，忽略", req.Username)
+		return &user.RequestPasswordResetResponse{Success: true}, nil
+	}
+	if u.Mobile == "" {
+		log.Printf("[User] 找回密码: 用户 %d 未绑定手机号，无法投递令牌", u.ID)
+		return &user.RequestPasswordResetResponse{Success: true}, nil
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "生成令牌失败")
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 作废该用户此前未使用的令牌，保证同一时间只有一个有效令牌
+		if err := tx.Where("user_id = ? AND used_at IS NULL", u.ID).Delete(&model.PasswordReset{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.PasswordReset{
+			UserID:    u.ID,
+			TokenHash: hashResetToken(token),
+			ExpiresAt: time.Now().Add(s.resetTTL),
+		}).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "保存令牌失败")
+	}
+
+	content := fmt.Sprintf("您正在找回密码，重置令牌为 %s，%d 分钟内有效。如非本人操作请忽略。", token, int(s.resetTTL.Minutes()))
+	if err := s.sender.Send(ctx, u.Mobile, "找回密码", content); err != nil {
+		log.Printf("[Error] 投递找回密码令牌失败: %v", err)
+		return nil, status.Error(codes.Unavailable, "令牌发送失败，请稍后重试")
+	}
+
+	return &user.RequestPasswordResetResponse{Success: true}, nil
+}
+
+// ResetPassword 凭令牌重置密码
+func (s *server) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.ResetPasswordResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "令牌不能为空")
+	}
+	if err := s.policy.Validate(req.NewPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hashedPwd, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "加密失败")
+	}
+
+	errInvalidToken := errors.New("invalid token")
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var pr model.PasswordReset
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", hashResetToken(req.Token), time.Now()).
+			First(&pr).Error; err != nil {
+			return errInvalidToken
+		}
+
+		// 条件更新兜底：并发请求中只有一个能把令牌标记为已使用
+		now := time.Now()
+		used := tx.Model(&model.PasswordReset{}).Where("id = ? AND used_at IS NULL", pr.ID).Update("used_at", &now)
+		if used.Error != nil {
+			return used.Error
+		}
+		if used.RowsAffected != 1 {
+			return errInvalidToken
+		}
+		result := tx.Model(&model.User{}).Where("id = ?", pr.UserID).Update("password", hashedPwd)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errInvalidToken
+		}
+		return nil
+	})
+	if errors.Is(err, errInvalidToken) {
+		return nil, status.Error(codes.InvalidArgument, "令牌无效或已过期")
+	}
+	if err != nil {
+		log.Printf("[Error] 重置密码失败: %v", err)
+		return nil, status.Error(codes.Internal, "数据库更新失败")
+	}
+
+	return &user.ResetPasswordResponse{Success: true}, nil
+}
+
+// OAuthStart 生成第三方登录授权地址
+func (s *server) OAuthStart(ctx context.Context, req *user.OAuthStartRequest) (*user.OAuthStartResponse, error) {
+	p, ok := s.providers[req.Provider]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "不支持的登录方式: %s", req.Provider)
+	}
+	if req.LinkUserId != 0 && req.ReauthUserId != 0 {
+		return nil, status.Error(codes.InvalidArgument, "绑定与重新认证不能同时发起")
+	}
+
+	nonce, err := oauth.NewNonce()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "生成 nonce 失败")
+	}
+	state, err := s.stateCdc.Encode(oauth.State{
+		Provider: p.Name(), Nonce: nonce, LinkUserID: req.LinkUserId, ReauthUserID: req.ReauthUserId,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "生成 state 失败")
+	}
+	authURL, err := p.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		log.Printf("[OAuth] %s 生成授权地址失败: %v", p.Name(), err)
+		return nil, status.Error(codes.Unavailable, "第三方登录暂不可用")
+	}
+
+	return &user.OAuthStartResponse{AuthUrl: authURL, State: state}, nil
+}
+
+// OAuthCallback 处理 IdP 回调
+// 1. 已绑定的身份 -> 直接登录
+// 2. state 中带有 LinkUserID -> 绑定到当前登录用户
+// 3. 其余情况 -> 自动注册新用户并绑定
+// state 中带有 ReauthUserID 时只做身份确认，不登录也不绑定
+func (s *server) OAuthCallback(ctx context.Context, req *user.OAuthCallbackRequest) (*user.OAuthCallbackResponse, error) {
+	p, ok := s.providers[req.Provider]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "不支持的登录方式: %s", req.Provider)
+	}
+	st, err := s.stateCdc.Decode(req.State)
+	if err != nil || st.Provider != p.Name() {
+		return nil, status.Error(codes.InvalidArgument, "登录请求已失效，请重新发起")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "缺少授权码")
+	}
+
+	identity, err := p.Exchange(ctx, req.Code, st.Nonce)
+	if err != nil {
+		log.Printf("[OAuth] %s 授权码校验失败: %v", p.Name(), err)
+		return nil, status.Error(codes.Unauthenticated, "第三方身份校验失败")
+	}
+	if st.ReauthUserID != 0 {
+		return s.confirmDeletion(p.Name(), identity.Subject, st.ReauthUserID)
+	}
+
+	var (
+		u       model.User
+		isNew   bool
+		linked  bool
+		link    model.IdentityLink
+		errSkip = errors.New("already linked")
+	)
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("provider = ? AND subject = ?", p.Name(), identity.Subject).First(&link).Error
+		if err == nil {
+			if st.LinkUserID != 0 && int64(link.UserID) != st.LinkUserID {
+				return status.Error(codes.AlreadyExists, "该第三方账号已绑定其他用户")
+			}
+			if err := tx.First(&u, link.UserID).Error; err != nil {
+				return status.Error(codes.NotFound, "绑定的用户不存在")
+			}
+			return errSkip
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if st.LinkUserID != 0 {
+			// 绑定模式：同一用户同一提供方只允许绑定一个身份
+			if err := tx.First(&u, st.LinkUserID).Error; err != nil {
+				return status.Error(codes.NotFound, "用户不存在")
+			}
+			var cnt int64
+			tx.Model(&model.IdentityLink{}).Where("user_id = ? AND provider = ?", u.ID, p.Name()).Count(&cnt)
+			if cnt > 0 {
+				return status.Error(codes.AlreadyExists, "已绑定该登录方式，请先解绑")
+			}
+			linked = true
+		} else {
+			// 自动注册：密码留空，登录只能通过第三方或设置密码后进行
+			nickname := identity.Name
+			if nickname == "" {
+				nickname = identity.Email
+			}
+			u = model.User{
+				Username: oauthUsername(p.Name(), identity.Subject),
+				Nickname: nickname,
+				Avatar:   identity.Picture,
+			}
+			if err := tx.Create(&u).Error; err != nil {
+				return err
+			}
+			isNew = true
+		}
+
+		return tx.Create(&model.IdentityLink{
+			UserID:   u.ID,
+			Provider: p.Name(),
+			Subject:  identity.Subject,
+			Email:    identity.Email,
+		}).Error
+	})
+	if err != nil && !errors.Is(err, errSkip) {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("[OAuth] 保存第三方身份失败: %v", err)
+		return nil, status.Error(codes.Internal, "登录失败")
+	}
+
+	token, err := issueToken(u.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to generate token")
+	}
+	log.Printf("[OAuth] 用户 %d 通过 %s 登录 (new=%v linked=%v)", u.ID, p.Name(), isNew, linked)
+
+	return &user.OAuthCallbackResponse{
+		UserId:    int64(u.ID),
+		Token:     token,
+		Role:      u.Role,
+		IsNewUser: isNew,
+		Linked:    linked,
+	}, nil
+}
+
+// confirmDeletion 重新认证模式：IdP 返回的身份必须已绑定到发起用户，
+// 校验通过后签发一次性注销确认令牌
+func (s *server) confirmDeletion(provider, subject string, userID int64) (*user.OAuthCallbackResponse, error) {
+	var link model.IdentityLink
+	err := s.db.Where("provider = ? AND subject = ?", provider, subject).First(&link).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && int64(link.UserID) != userID) {
+		return nil, status.Error(codes.PermissionDenied, "第三方账号与当前用户不匹配")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "生成令牌失败")
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 同一时间只保留一个有效令牌
+		if err := tx.Unscoped().Where("user_id = ?", link.UserID).Delete(&model.DeletionConfirm{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.DeletionConfirm{
+			UserID:    link.UserID,
+			TokenHash: hashResetToken(token),
+			ExpiresAt: time.Now().Add(deletionConfirmTTL),
+		}).Error
+	})
+	if err != nil {
+		log.Printf("[Error] 保存注销确认令牌失败: %v", err)
+		return nil, status.Error(codes.Internal, "保存令牌失败")
+	}
+
+	log.Printf("[OAuth] 用户 %d 通过 %s 重新认证以注销账号", link.UserID, provider)
+	return &user.OAuthCallbackResponse{UserId: userID, ReauthToken: token}, nil
+}
+
+// ListIdentityLinks 查询用户绑定的第三方身份
+func (s *server) ListIdentityLinks(ctx context.Context, req *user.ListIdentityLinksRequest) (*user.ListIdentityLinksResponse, error) {
+	var links []model.IdentityLink
+	if err := s.db.Where("user_id = ?", req.UserId).Order("id asc").Find(&links).Error; err != nil {
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+	var res []*user.IdentityLink
+	for _, l := range links {
+		res = append(res, &user.IdentityLink{
+			Provider:  l.Provider,
+			Subject:   l.Subject,
+			Email:     l.Email,
+			CreatedAt: l.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &user.ListIdentityLinksResponse{Links: res}, nil
+}
+
+// UnlinkIdentity 解绑第三方身份
+// 未设置密码的用户不能解绑最后一个身份，否则账号将无法再登录
+func (s *server) UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityRequest) (*user.UnlinkIdentityResponse, error) {
+	var u model.User
+	if err := s.db.First(&u, req.UserId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "用户不存在")
+	}
+	if u.Password == "" {
+		var cnt int64
+		s.db.Model(&model.IdentityLink{}).Where("user_id = ?", u.ID).Count(&cnt)
+		if cnt <= 1 {
+			return nil, status.Error(codes.FailedPrecondition, "请先设置登录密码再解绑")
+		}
+	}
+
+	// 物理删除，允许之后重新绑定同一身份 (provider+subject 唯一索引)
+	result := s.db.Unscoped().Where("user_id = ? AND provider = ?", req.UserId, req.Provider).Delete(&model.IdentityLink{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "数据库错误")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "未绑定该登录方式")
+	}
+	return &user.UnlinkIdentityResponse{Success: true}, nil
+}
+
+// RequestDataExport 申请导出个人数据
+// 导出在后台异步生成，前端通过 GetDataExport 轮询状态并下载
+func (s *server) RequestDataExport(ctx context.Context, req *user.RequestDataExportRequest) (*user.RequestDataExportResponse, error) {
+	var u model.User
+	if err := s.db.First(&u, req.UserId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "用户不存在")
+	}
+
+	// 同一时间只允许一个进行中的导出任务
+	var running int64
+	s.db.Model(&model.DataExport{}).Where("user_id = ? AND status = ?", u.ID, model.ExportStatusPending).Count(&running)
+	if running > 0 {
+		return nil, status.Error(codes.AlreadyExists, "已有导出任务正在生成，请稍后再试")
+	}
+
+	exp := model.DataExport{
+		UserID:    u.ID,
+		Status:    model.ExportStatusPending,
+		ExpiresAt: time.Now().Add(dataExportTTL),
+	}
+	if err := s.db.Create(&exp).Error; err != nil {
+		return nil, status.Error(codes.Internal, "创建导出任务失败")
+	}
+
+	go s.buildDataExport(exp.ID, u)
+
+	return &user.RequestDataExportResponse{ExportId: int64(exp.ID)}, nil
+}
+
+// GetDataExport 查询导出任务，生成完成时返回归档内容
+func (s *server) GetDataExport(ctx context.Context, req *user.GetDataExportRequest) (*user.GetDataExportResponse, error) {
+	var exp model.DataExport
+	if err := s.db.Where("id = ? AND user_id = ?", req.ExportId, req.UserId).First(&exp).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "导出任务不存在")
+	}
+	if time.Now().After(exp.ExpiresAt) {
+		return nil, status.Error(codes.NotFound, "导出文件已过期，请重新申请")
+	}
+
+	resp := &user.GetDataExportResponse{
+		ExportId:  int64(exp.ID),
+		Status:    exp.Status,
+		FileName:  fmt.Sprintf("gomall-export-%d-%s.json", exp.UserID, exp.CreatedAt.Format("20060102150405")),
+		CreatedAt: exp.CreatedAt.Format("2006-01-02 15:04:05"),
+		ExpiresAt: exp.ExpiresAt.Format("2006-01-02 15:04:05"),
+		Error:     exp.Error,
+	}
+	if exp.Status == model.ExportStatusReady {
+		resp.Content = exp.Content
+	}
+	return resp, nil
+}
+
+// buildDataExport 汇总用户在各服务中的数据并写入导出任务
+func (s *server) buildDataExport(exportID uint, u model.User) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	content, err := s.collectUserData(ctx, u)
+	updates := map[string]interface{}{"status": model.ExportStatusReady, "content": content}
+	if err != nil {
+		log.Printf("[User] 用户 %d 数据导出失败: %v", u.ID, err)
+		updates = map[string]interface{}{"status": model.ExportStatusFailed, "error": err.Error()}
+	}
+	if err := s.db.Model(&model.DataExport{}).Where("id = ?", exportID).Updates(updates).Error; err != nil {
+		log.Printf("[User] 更新导出任务 %d 失败: %v", exportID, err)
+	}
+
+	// 顺带清理过期的导出文件
+	s.db.Unscoped().Where("expires_at < ?", time.Now()).Delete(&model.DataExport{})
+}
+
+// collectUserData 生成个人数据 JSON 归档
+func (s *server) collectUserData(ctx context.Context, u model.User) ([]byte, error) {
+	uid := int64(u.ID)
+
+	var links []model.IdentityLink
+	if err := s.db.Where("user_id = ?", u.ID).Find(&links).Error; err != nil {
+		return nil, fmt.Errorf("查询第三方身份失败: %w", err)
+	}
+	var identities []map[string]string
+	for _, l := range links {
+		identities = append(identities, map[string]string{
+			"provider":   l.Provider,
+			"subject":    l.Subject,
+			"email":      l.Email,
+			"created_at": l.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	addrResp, err := s.addressClient.ListAddress(ctx, &address.ListAddressRequest{UserId: uid})
+	if err != nil {
+		return nil, fmt.Errorf("查询收货地址失败: %w", err)
+	}
+	orderResp, err := s.orderClient.ListOrders(ctx, &order.ListOrdersRequest{UserId: uid})
+	if err != nil {
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	reviewResp, err := s.reviewClient.ListUserReviews(ctx, &review.ListUserReviewsRequest{UserId: uid})
+	if err != nil {
+		return nil, fmt.Errorf("查询评价失败: %w", err)
+	}
+	cartResp, err := s.cartClient.GetCart(ctx, &cart.GetCartRequest{UserId: uid})
+	if err != nil {
+		return nil, fmt.Errorf("查询购物车失败: %w", err)
+	}
+
+	archive := map[string]interface{}{
+		"exported_at": time.Now().Format(time.RFC3339),
+		"profile": map[string]interface{}{
+			"id":         u.ID,
+			"username":   u.Username,
+			"nickname":   u.Nickname,
+			"mobile":     u.Mobile,
+			"avatar":     u.Avatar,
+			"role":       u.Role,
+			"created_at": u.CreatedAt.Format("2006-01-02 15:04:05"),
+		},
+		"identity_links": identities,
+		"addresses":      addrResp.Addresses,
+		"orders":         orderResp.Orders,
+		"reviews":        reviewResp.Reviews,
+		"cart":           cartResp.Items,
+	}
+	return json.MarshalIndent(archive, "", "  ")
+}
+
+// DeleteAccount 注销账号
+// 依次让各服务匿名化/清除个人数据，全部成功后再匿名化并软删除用户本身；
+// 每一步都是幂等的，中途失败时用户可直接重试 (确认令牌在有效期内可重复使用，注销完成时删除)
+// 未设置密码的用户 (仅第三方登录) 须先通过第三方重新认证取得确认令牌
+func (s *server) DeleteAccount(ctx context.Context, req *user.DeleteAccountRequest) (*user.DeleteAccountResponse, error) {
+	var u model.User
+	if err := s.db.First(&u, req.UserId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "用户不存在")
+	}
+	if !req.ByAdmin {
+		if u.Password != "" {
+			if !utils.CheckPassword(req.Password, u.Password) {
+				return nil, status.Error(codes.PermissionDenied, "密码错误")
+			}
+		} else if err := s.checkDeletionConfirm(u.ID, req.ReauthToken); err != nil {
+			return nil, err
+		}
+	}
+
+	uid := int64(u.ID)
+	// 订单最先处理：存在未完成订单时直接拒绝，不做任何修改
+	if _, err := s.orderClient.AnonymizeUserOrders(ctx, &order.AnonymizeUserOrdersRequest{UserId: uid}); err != nil {
+		return nil, err
+	}
+	if _, err := s.reviewClient.AnonymizeUserReviews(ctx, &review.AnonymizeUserReviewsRequest{UserId: uid}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "匿名化评价失败: %v", status.Convert(err).Message())
+	}
+	if _, err := s.addressClient.PurgeUserAddresses(ctx, &address.PurgeUserAddressesRequest{UserId: uid}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "清除收货地址失败: %v", status.Convert(err).Message())
+	}
+	if _, err := s.cartClient.EmptyCart(ctx, &cart.EmptyCartRequest{UserId: uid}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "清空购物车失败: %v", status.Convert(err).Message())
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range []interface{}{&model.IdentityLink{}, &model.PasswordReset{}, &model.DataExport{}, &model.DeletionConfirm{}} {
+			if err := tx.Unscoped().Where("user_id = ?", u.ID).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		// 用户名改为不可登录的占位符 (同时释放原用户名)，保留 ID 供订单关联
+		if err := tx.Model(&u).Updates(map[string]interface{}{
+			"username": fmt.Sprintf("deleted_%d_%d", u.ID, time.Now().Unix()),
+			"password": "",
+			"mobile":   "",
+			"nickname": "已注销用户",
+			"avatar":   "",
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&u).Error
+	})
+	if err != nil {
+		log.Printf("[Error] 注销用户 %d 失败: %v", u.ID, err)
+		return nil, status.Error(codes.Internal, "注销失败")
+	}
+
+	log.Printf("[User] 用户 %d 已注销 (by_admin=%v)", u.ID, req.ByAdmin)
+	return &user.DeleteAccountResponse{Success: true}, nil
+}
+
+// checkDeletionConfirm 校验无密码用户的注销确认令牌
+func (s *server) checkDeletionConfirm(userID uint, token string) error {
+	if token == "" {
+		return status.Error(codes.PermissionDenied, "请先通过第三方账号重新认证")
+	}
+	var cnt int64
+	err := s.db.Model(&model.DeletionConfirm{}).
+		Where("user_id = ? AND token_hash = ? AND expires_at > ?", userID, hashResetToken(token), time.Now()).
+		Count(&cnt).Error
+	if err != nil {
+		return status.Error(codes.Internal, "查询失败")
+	}
+	if cnt == 0 {
+		return status.Error(codes.PermissionDenied, "确认令牌无效或已过期，请重新认证")
+	}
+	return nil
+}
+
+// oauthUsername 为第三方自动注册的用户生成唯一用户名
+func oauthUsername(provider, subject string) string {
+	sum := sha256.Sum256([]byte(provider + ":" + subject))
+	return fmt.Sprintf("%s_%s", provider, hex.EncodeToString(sum[:])[:16])
+}
+
+// newResetToken 生成 32 字节随机令牌 (hex 编码)
+func newResetToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashResetToken 令牌只以摘要形式落库
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// passwordPolicyFromConfig 由配置构建密码策略，未配置时使用默认策略
+func passwordPolicyFromConfig(c config.PasswordConfig) utils.PasswordPolicy {
+	if c.MinLength == 0 && c.MaxLength == 0 {
+		return utils.DefaultPasswordPolicy
+	}
+	return utils.PasswordPolicy{
+		MinLength:      c.MinLength,
+		MaxLength:      c.MaxLength,
+		RequireLetter:  c.RequireLetter,
+		RequireDigit:   c.RequireDigit,
+		RequireUpper:   c.RequireUpper,
+		RequireSpecial: c.RequireSpecial,
+	}
+}
+
 func main() {
 	c, err := config.LoadConfig(".")
 	if err != nil {
@@ -211,12 +813,29 @@ func main() {
 	if v := os.Getenv("CONSUL_ADDRESS"); v != "" {
 		c.Consul.Address = v
 	}
+	if v := os.Getenv("USER_OAUTH_STATE_SECRET"); v != "" {
+		c.OAuth.StateSecret = v
+	}
+	if c.OAuth.StateSecret == "" {
+		log.Fatalf("未配置第三方登录 state 签名密钥 (oauth.state_secret)")
+	}
 
 	db, err := database.InitMySQL(c.Mysql)
 	if err != nil {
 		log.Fatalf("Failed to init mysql: %v", err)
 	}
-	db.AutoMigrate(&model.User{})
+	db.AutoMigrate(&model.User{}, &model.PasswordReset{}, &model.IdentityLink{}, &model.DataExport{}, &model.DeletionConfirm{})
+
+	// 密码加密强度
+	if c.Password.BcryptCost > 0 {
+		if err := utils.SetHashCost(c.Password.BcryptCost); err != nil {
+			log.Fatalf("Invalid password config: %v", err)
+		}
+	}
+	resetTTL := time.Duration(c.Password.ResetTokenTTL) * time.Minute
+	if resetTTL <= 0 {
+		resetTTL = 30 * time.Minute
+	}
 
 	addr := fmt.Sprintf(":%d", c.Service.Port)
 	lis, err := net.Listen("tcp", addr)
@@ -230,7 +849,47 @@ func main() {
 	}
 
 	s := grpc.NewServer()
-	user.RegisterUserServiceServer(s, &server{db: db})
+	// 第三方登录提供方
+	providers := make(map[string]*oauth.Provider)
+	for _, pc := range c.OAuth.Providers {
+		providers[pc.Name] = oauth.NewProvider(oauth.ProviderConfig{
+			Name:         pc.Name,
+			Issuer:       pc.Issuer,
+			ClientID:     pc.ClientID,
+			ClientSecret: pc.ClientSecret,
+			RedirectURL:  pc.RedirectURL,
+			Scopes:       pc.Scopes,
+		}, nil)
+		log.Printf("OAuth provider enabled: %s", pc.Name)
+	}
+	stateTTL := time.Duration(c.OAuth.StateTTL) * time.Second
+	if stateTTL <= 0 {
+		stateTTL = 10 * time.Minute
+	}
+
+	// 下游服务 (个人数据导出/注销账号)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	}
+	addrConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "address-service"), opts...)
+	orderConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "order-service"), opts...)
+	reviewConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "review-service"), opts...)
+	cartConn, _ := grpc.Dial(fmt.Sprintf("consul://%s/%s?wait=14s", c.Consul.Address, "cart-service"), opts...)
+
+	user.RegisterUserServiceServer(s, &server{
+		db:        db,
+		policy:    passwordPolicyFromConfig(c.Password),
+		sender:    notify.NewSender(c.Notify.Channel),
+		resetTTL:  resetTTL,
+		providers: providers,
+		stateCdc:  oauth.NewStateCodec([]byte(c.OAuth.StateSecret), stateTTL),
+
+		addressClient: address.NewAddressServiceClient(addrConn),
+		orderClient:   order.NewOrderServiceClient(orderConn),
+		reviewClient:  review.NewReviewServiceClient(reviewConn),
+		cartClient:    cart.NewCartServiceClient(cartConn),
+	})
 	reflection.Register(s)
 
 	log.Printf("User Service listening on %s", addr)
@@ -1,6 +1,10 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	gorm.Model        // 包含了 ID, CreatedAt, UpdatedAt, DeletedAt
@@ -16,3 +20,64 @@ type User struct {
 func (User) TableName() string {
 	return "users"
 }
+
+// PasswordReset 找回密码令牌
+// 只保存令牌的 SHA-256 摘要，明文令牌仅通过 Sender 投递给用户
+type PasswordReset struct {
+	gorm.Model
+	UserID    uint      `gorm:"index;not null"`
+	TokenHash string    `gorm:"type:char(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+}
+
+func (PasswordReset) TableName() string {
+	return "password_resets"
+}
+
+// DeletionConfirm 无密码账号注销确认令牌
+// 通过第三方重新认证后签发，短期有效，同样只保存 SHA-256 摘要
+type DeletionConfirm struct {
+	gorm.Model
+	UserID    uint      `gorm:"index;not null"`
+	TokenHash string    `gorm:"type:char(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+func (DeletionConfirm) TableName() string {
+	return "deletion_confirms"
+}
+
+// IdentityLink 第三方身份绑定 (provider + subject 唯一对应一个本地用户)
+type IdentityLink struct {
+	gorm.Model
+	UserID   uint   `gorm:"index;not null"`
+	Provider string `gorm:"type:varchar(32);not null;uniqueIndex:uni_provider_subject"`
+	Subject  string `gorm:"type:varchar(255);not null;uniqueIndex:uni_provider_subject"`
+	Email    string `gorm:"type:varchar(255)"`
+}
+
+func (IdentityLink) TableName() string {
+	return "identity_links"
+}
+
+// 个人数据导出状态
+const (
+	ExportStatusPending = "pending"
+	ExportStatusReady   = "ready"
+	ExportStatusFailed  = "failed"
+)
+
+// DataExport 个人数据导出任务，生成的 JSON 归档在有效期内可重复下载
+type DataExport struct {
+	gorm.Model
+	UserID    uint      `gorm:"index;not null"`
+	Status    string    `gorm:"type:varchar(20);not null;default:'pending'"`
+	Content   []byte    `gorm:"type:longblob"`
+	Error     string    `gorm:"type:varchar(255)"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+func (DataExport) TableName() string {
+	return "data_exports"
+}
@@ -0,0 +1,310 @@
+// Package oauth 实现第三方登录所需的 OIDC 授权码流程 (Authorization Code Flow)
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ProviderConfig 单个身份提供方 (IdP) 的配置
+type ProviderConfig struct {
+	Name         string // 提供方标识，如 google、github-oidc
+	Issuer       string // OIDC Issuer，用于自动发现 (/.well-known/openid-configuration)
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string   // 回调地址，需与 IdP 后台登记的一致
+	Scopes       []string // 默认 openid profile email
+}
+
+// Identity 从 ID Token 中解析出的第三方身份
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// discoveryDoc OIDC 自动发现文档 (只取用到的字段)
+type discoveryDoc struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims ID Token 载荷
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	jwt.RegisteredClaims
+}
+
+// Provider OIDC 客户端
+// 发现文档与签名公钥按需拉取并缓存，IdP 暂时不可用不会影响服务启动
+type Provider struct {
+	cfg    ProviderConfig
+	client *http.Client
+
+	mu   sync.Mutex
+	doc  *discoveryDoc
+	keys map[string]*rsa.PublicKey
+}
+
+// NewProvider 创建 OIDC 客户端，client 为空时使用带超时的默认 http.Client
+func NewProvider(cfg ProviderConfig, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "profile", "email"}
+	}
+	cfg.Issuer = strings.TrimRight(cfg.Issuer, "/")
+	return &Provider{cfg: cfg, client: client}
+}
+
+// Name 提供方标识
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL 生成跳转到 IdP 登录页的地址
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.cfg.ClientID)
+	v.Set("redirect_uri", p.cfg.RedirectURL)
+	v.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+
+	sep := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return doc.AuthorizationEndpoint + sep + v.Encode(), nil
+}
+
+// Exchange 用授权码换取 ID Token 并完成校验 (签名、iss、aud、exp、nonce)
+func (p *Provider) Exchange(ctx context.Context, code, nonce string) (*Identity, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("client_secret", p.cfg.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求 token 接口失败: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token 接口返回 %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tok struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return nil, fmt.Errorf("解析 token 响应失败: %w", err)
+	}
+	if tok.IDToken == "" {
+		return nil, errors.New("token 响应中缺少 id_token")
+	}
+	return p.verifyIDToken(ctx, tok.IDToken, nonce)
+}
+
+// verifyIDToken 校验 ID Token 并提取身份
+func (p *Provider) verifyIDToken(ctx context.Context, raw, nonce string) (*Identity, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	_, err = jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("id_token 校验失败: %w", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("id_token nonce 不匹配")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("id_token 缺少 sub")
+	}
+
+	return &Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+// discover 拉取并缓存 OIDC 发现文档
+func (p *Provider) discover(ctx context.Context) (*discoveryDoc, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.doc != nil {
+		return p.doc, nil
+	}
+
+	var doc discoveryDoc
+	if err := p.getJSON(ctx, p.cfg.Issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("OIDC 发现失败: %w", err)
+	}
+	if doc.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("OIDC 发现文档 issuer 不匹配: %s", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		return nil, errors.New("OIDC 发现文档缺少必要的端点")
+	}
+	p.doc = &doc
+	return p.doc, nil
+}
+
+// publicKey 按 kid 查找签名公钥，未命中时刷新一次 JWKS (应对 IdP 轮换密钥)
+func (p *Provider) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	if err := p.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	// IdP 只发布一把密钥且 token 未带 kid 时直接使用
+	if kid == "" && len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("未找到签名公钥 kid=%q", kid)
+}
+
+func (p *Provider) refreshKeys(ctx context.Context) error {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, doc.JwksURI, &set); err != nil {
+		return fmt.Errorf("拉取 JWKS 失败: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s 返回 %d", u, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
+
+// NewNonce 生成随机 nonce
+func NewNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
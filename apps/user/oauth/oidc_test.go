@@ -0,0 +1,252 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockIdP 本地模拟的 OIDC 服务端
+type mockIdP struct {
+	srv      *httptest.Server
+	key      *rsa.PrivateKey
+	kid      string
+	codes    map[string]jwt.MapClaims // code -> 待签发的 id_token 载荷
+	clientID string
+	secret   string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIdP{key: key, kid: "k1", codes: map[string]jwt.MapClaims{}, clientID: "mall", secret: "s3cret"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.srv.URL,
+			"authorization_endpoint": m.srv.URL + "/authorize",
+			"token_endpoint":         m.srv.URL + "/token",
+			"jwks_uri":               m.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": m.kid,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.Form.Get("client_id") != m.clientID || r.Form.Get("client_secret") != m.secret {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		claims, ok := m.codes[r.Form.Get("code")]
+		if !ok {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		delete(m.codes, r.Form.Get("code"))
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"id_token":     m.sign(t, claims),
+		})
+	})
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *mockIdP) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tok.Header["kid"] = m.kid
+	s, err := tok.SignedString(m.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// issueCode 登记一个授权码，对应默认的合法载荷，可通过 mutate 篡改
+func (m *mockIdP) issueCode(code, nonce string, mutate func(jwt.MapClaims)) {
+	claims := jwt.MapClaims{
+		"iss":            m.srv.URL,
+		"sub":            "user-123",
+		"aud":            m.clientID,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "veggie@example.com",
+		"email_verified": true,
+		"name":           "寿光菜农",
+	}
+	if mutate != nil {
+		mutate(claims)
+	}
+	m.codes[code] = claims
+}
+
+func (m *mockIdP) provider() *Provider {
+	return NewProvider(ProviderConfig{
+		Name:         "mock",
+		Issuer:       m.srv.URL,
+		ClientID:     m.clientID,
+		ClientSecret: m.secret,
+		RedirectURL:  "http://localhost:8080/api/v1/user/oauth/mock/callback",
+	}, m.srv.Client())
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	idp := newMockIdP(t)
+	raw, err := idp.provider().AuthCodeURL(context.Background(), "st", "nn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(raw, idp.srv.URL+"/authorize?") {
+		t.Fatalf("unexpected authorize endpoint: %s", raw)
+	}
+	q := u.Query()
+	want := map[string]string{
+		"response_type": "code",
+		"client_id":     "mall",
+		"state":         "st",
+		"nonce":         "nn",
+		"scope":         "openid profile email",
+		"redirect_uri":  "http://localhost:8080/api/v1/user/oauth/mock/callback",
+	}
+	for k, v := range want {
+		if q.Get(k) != v {
+			t.Errorf("%s = %q, want %q", k, q.Get(k), v)
+		}
+	}
+}
+
+func TestExchange(t *testing.T) {
+	idp := newMockIdP(t)
+	p := idp.provider()
+	idp.issueCode("good", "nonce-1", nil)
+
+	id, err := p.Exchange(context.Background(), "good", "nonce-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Subject != "user-123" || id.Email != "veggie@example.com" || !id.EmailVerified || id.Name != "寿光菜农" {
+		t.Fatalf("unexpected identity: %+v", id)
+	}
+
+	// 授权码只能使用一次
+	if _, err := p.Exchange(context.Background(), "good", "nonce-1"); err == nil {
+		t.Fatal("expected error when reusing code")
+	}
+}
+
+func TestExchangeRejectsInvalidIDToken(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		nonce  string
+		mutate func(jwt.MapClaims)
+	}{
+		{"nonce mismatch", "other-nonce", nil},
+		{"wrong audience", "n", func(c jwt.MapClaims) { c["aud"] = "someone-else" }},
+		{"wrong issuer", "n", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{"expired", "n", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{"missing subject", "n", func(c jwt.MapClaims) { delete(c, "sub") }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.issueCode("c", "n", tc.mutate)
+			if _, err := idp.provider().Exchange(context.Background(), "c", tc.nonce); err == nil {
+				t.Fatal("expected verification error")
+			}
+		})
+	}
+
+	t.Run("bad signature", func(t *testing.T) {
+		idp := newMockIdP(t)
+		p := idp.provider()
+		good := idp.key
+		idp.key = otherKey
+		forged := idp.sign(t, jwt.MapClaims{
+			"iss": idp.srv.URL, "sub": "x", "aud": "mall", "nonce": "n",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		idp.key = good
+		if _, err := p.verifyIDToken(context.Background(), forged, "n"); err == nil {
+			t.Fatal("expected signature error")
+		}
+	})
+}
+
+func TestExchangeRejectsBadClientSecret(t *testing.T) {
+	idp := newMockIdP(t)
+	idp.issueCode("c", "n", nil)
+	p := idp.provider()
+	p.cfg.ClientSecret = "wrong"
+	if _, err := p.Exchange(context.Background(), "c", "n"); err == nil {
+		t.Fatal("expected invalid_client error")
+	}
+}
+
+func TestStateCodec(t *testing.T) {
+	c := NewStateCodec([]byte("secret"), time.Minute)
+	raw, err := c.Encode(State{Provider: "mock", Nonce: "n", LinkUserID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := c.Decode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Provider != "mock" || st.Nonce != "n" || st.LinkUserID != 7 {
+		t.Fatalf("unexpected state: %+v", st)
+	}
+
+	if _, err := NewStateCodec([]byte("other"), time.Minute).Decode(raw); err != ErrInvalidState {
+		t.Fatalf("expected ErrInvalidState for wrong secret, got %v", err)
+	}
+	if _, err := c.Decode(raw[:len(raw)-2] + "xx"); err != ErrInvalidState {
+		t.Fatalf("expected ErrInvalidState for tampered state, got %v", err)
+	}
+
+	expired := NewStateCodec([]byte("secret"), -time.Minute)
+	raw, _ = expired.Encode(State{Provider: "mock"})
+	if _, err := expired.Decode(raw); err != ErrInvalidState {
+		t.Fatalf("expected ErrInvalidState for expired state, got %v", err)
+	}
+}
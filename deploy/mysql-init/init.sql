@@ -1,8 +1,8 @@
 /*
  * Go Mall 数据库全量初始化脚本 (Shouguang Veggie - Final Stable Edition)
- * 1. 移除了 db_cart，保持架构精简
+ * 1. db_cart 仅作为 Redis 购物车的持久化备份 (Redis 仍是主存储)
  * 2. users 表保持结构完整（含 role, is_disabled 字段），不填充测试数据
- * 3. 商品列表 (products) 保留原有 ID 与核心数据，并固化分类 (category) 字段
+ * 3. 商品列表 (products) 保留原有 ID 与核心数据，分类统一引用 categories 表 (category_id)
  */
 
 SET NAMES utf8mb4;
@@ -43,13 +43,82 @@ CREATE TABLE `addresses` (
     `province` varchar(50) DEFAULT NULL,
     `city` varchar(50) DEFAULT NULL,
     `district` varchar(50) DEFAULT NULL,
+    `province_code` varchar(12) DEFAULT NULL COMMENT '行政区划编码 (GB/T 2260)',
+    `city_code` varchar(12) DEFAULT NULL,
+    `district_code` varchar(12) DEFAULT NULL,
     `detail_address` varchar(255) DEFAULT NULL,
     `is_default` tinyint(1) DEFAULT 0,
     `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
     `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `deleted_at` datetime DEFAULT NULL,
     PRIMARY KEY (`id`),
-    KEY `idx_user_id` (`user_id`)
+    KEY `idx_user_id` (`user_id`),
+    KEY `idx_addresses_deleted_at` (`deleted_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+-- 地址服务按用户加锁，串行化同一用户的地址写操作
+CREATE TABLE `address_user_locks` (
+    `user_id` bigint(20) NOT NULL,
+    PRIMARY KEY (`user_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `password_resets` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` bigint(20) unsigned NOT NULL,
+    `token_hash` char(64) NOT NULL COMMENT '重置令牌 SHA-256 摘要',
+    `expires_at` datetime NOT NULL,
+    `used_at` datetime DEFAULT NULL,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_password_resets_token_hash` (`token_hash`),
+    KEY `idx_password_resets_user_id` (`user_id`),
+    KEY `idx_password_resets_deleted_at` (`deleted_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `deletion_confirms` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` bigint(20) unsigned NOT NULL,
+    `token_hash` char(64) NOT NULL COMMENT '注销确认令牌 SHA-256 摘要',
+    `expires_at` datetime NOT NULL,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_deletion_confirms_token_hash` (`token_hash`),
+    KEY `idx_deletion_confirms_user_id` (`user_id`),
+    KEY `idx_deletion_confirms_deleted_at` (`deleted_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `identity_links` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` bigint(20) unsigned NOT NULL,
+    `provider` varchar(32) NOT NULL COMMENT '第三方身份提供方',
+    `subject` varchar(255) NOT NULL COMMENT 'IdP 用户唯一标识 (sub)',
+    `email` varchar(255) DEFAULT NULL,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uni_provider_subject` (`provider`, `subject`),
+    KEY `idx_identity_links_user_id` (`user_id`),
+    KEY `idx_identity_links_deleted_at` (`deleted_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `data_exports` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` bigint(20) unsigned NOT NULL,
+    `status` varchar(20) NOT NULL DEFAULT 'pending' COMMENT 'pending/ready/failed',
+    `content` longblob COMMENT '个人数据 JSON 归档',
+    `error` varchar(255) DEFAULT NULL,
+    `expires_at` datetime NOT NULL,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_data_exports_user_id` (`user_id`),
+    KEY `idx_data_exports_deleted_at` (`deleted_at`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
 -- =======================================================
@@ -69,10 +138,43 @@ CREATE TABLE `products` (
     `price` float(10, 2) NOT NULL,
     `stock` int(11) DEFAULT 1000,
     `category_id` int(11) DEFAULT '0',
-    `category` varchar(100) DEFAULT '其他' COMMENT '分类名',
-    PRIMARY KEY (`id`)
+    `status` varchar(16) DEFAULT 'on_sale' COMMENT 'draft / on_sale / off_shelf / deleted',
+    `on_shelf_at` datetime DEFAULT NULL COMMENT '定时上架时间',
+    `off_shelf_at` datetime DEFAULT NULL COMMENT '定时下架时间',
+    `sales_count` bigint(20) NOT NULL DEFAULT 0 COMMENT '累计销量',
+    `rating_sum` bigint(20) NOT NULL DEFAULT 0 COMMENT '评价星级之和',
+    `rating_count` bigint(20) NOT NULL DEFAULT 0 COMMENT '评价数',
+    PRIMARY KEY (`id`),
+    KEY `idx_products_category_id` (`category_id`),
+    KEY `idx_products_status` (`status`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `categories` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `name` varchar(50) NOT NULL,
+    `parent_id` bigint(20) DEFAULT 0 COMMENT '0 表示一级分类',
+    `sort` bigint(20) DEFAULT 0 COMMENT '同级按 sort 升序',
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_categories_parent_id` (`parent_id`),
+    KEY `idx_categories_deleted_at` (`deleted_at`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
+-- 分类树：新鲜蔬菜 (9) 下挂各蔬菜子类，水果类为独立的一级分类
+INSERT INTO
+    `categories` (`id`, `name`, `parent_id`, `sort`)
+VALUES (9, '新鲜蔬菜', 0, 1),
+    (7, '水果类', 0, 2),
+    (1, '茄果类', 9, 1),
+    (2, '瓜果类', 9, 2),
+    (3, '根茎类', 9, 3),
+    (4, '葱蒜类', 9, 4),
+    (5, '叶菜类', 9, 5),
+    (6, '菌菇类', 9, 6),
+    (8, '豆类', 9, 7);
+
 CREATE TABLE `skus` (
     `id` bigint(20) NOT NULL AUTO_INCREMENT,
     `product_id` bigint(20) NOT NULL,
@@ -80,6 +182,9 @@ CREATE TABLE `skus` (
     `price?` float(10, 2) DEFAULT NULL,
     `stock` int(11) DEFAULT 1000,
     `picture` varchar(255) DEFAULT NULL,
+    `weight` int(11) DEFAULT 0 COMMENT '重量(克)，用于计算运费',
+    `spec_key` varchar(255) DEFAULT '' COMMENT '规格值组合键：规格值 ID 升序逗号分隔',
+    `status` varchar(16) DEFAULT 'on_sale' COMMENT 'on_sale / off_shelf / deleted',
     `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
     `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `deleted_at` datetime DEFAULT NULL,
@@ -87,6 +192,45 @@ CREATE TABLE `skus` (
     KEY `idx_product_id` (`product_id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
+-- 商品规格维度 (如重量、等级、包装) 与可选值，SKU 在每个维度上选一个值
+CREATE TABLE `product_specs` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `product_id` bigint(20) NOT NULL,
+    `name` varchar(32) NOT NULL,
+    `sort` bigint(20) DEFAULT 0,
+    PRIMARY KEY (`id`),
+    KEY `idx_product_specs_product_id` (`product_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `product_spec_values` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `product_id` bigint(20) NOT NULL,
+    `spec_id` bigint(20) NOT NULL,
+    `value` varchar(32) NOT NULL,
+    `sort` bigint(20) DEFAULT 0,
+    PRIMARY KEY (`id`),
+    KEY `idx_product_spec_values_product_id` (`product_id`),
+    KEY `idx_product_spec_values_spec_id` (`spec_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+-- 商品变更 outbox：与商品写入同一事务记录，投递 product.changed 事件后由搜索索引消费者更新 ES
+CREATE TABLE `product_outbox` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `product_id` bigint(20) NOT NULL,
+    `sent` tinyint(1) DEFAULT 0,
+    `created_at` datetime(3) DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_product_outbox_sent` (`sent`),
+    KEY `idx_product_outbox_created_at` (`created_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+-- 一次性数据迁移记录 (如按已有订单与评价回填销量、评分)，商品服务启动时执行
+CREATE TABLE `data_migrations` (
+    `name` varchar(64) NOT NULL,
+    `applied_at` datetime(3) NOT NULL,
+    PRIMARY KEY (`name`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
 -- 插入 32 种寿光蔬菜水果 (整合分类优化版)
 INSERT INTO
     `products` (
@@ -96,8 +240,7 @@ INSERT INTO
         `picture`,
         `price`,
         `stock`,
-        `category_id`,
-        `category`
+        `category_id`
     )
 VALUES (
         1,
@@ -106,8 +249,7 @@ VALUES (
         'https://placehold.co/300x300/ff6347/ffffff?text=Tomato',
         5.80,
         500,
-        1,
-        '茄果类'
+        1
     ),
     (
         2,
@@ -116,8 +258,7 @@ VALUES (
         'https://placehold.co/300x300/32cd32/ffffff?text=Cucumber',
         4.50,
         800,
-        2,
-        '瓜果类'
+        2
     ),
     (
         3,
@@ -126,8 +267,7 @@ VALUES (
         'https://placehold.co/300x300/ff4500/ffffff?text=Carrot',
         2.80,
         1000,
-        3,
-        '根茎类'
+        3
     ),
     (
         4,
@@ -136,8 +276,7 @@ VALUES (
         'https://placehold.co/300x300/006400/ffffff?text=Leek',
         6.50,
         300,
-        4,
-        '葱蒜类'
+        4
     ),
     (
         5,
@@ -146,8 +285,7 @@ VALUES (
         'https://placehold.co/300x300/ffa500/ffffff?text=Pepper',
         8.90,
         400,
-        1,
-        '茄果类'
+        1
     ),
     (
         6,
@@ -156,8 +294,7 @@ VALUES (
         'https://placehold.co/300x300/90ee90/ffffff?text=Cabbage',
         1.50,
         2000,
-        5,
-        '叶菜类'
+        5
     ),
     (
         7,
@@ -166,8 +303,7 @@ VALUES (
         'https://placehold.co/300x300/f5f5f5/228b22?text=Scallion',
         3.20,
         1200,
-        4,
-        '葱蒜类'
+        4
     ),
     (
         8,
@@ -176,8 +312,7 @@ VALUES (
         'https://placehold.co/300x300/fffafa/a52a2a?text=Garlic',
         5.00,
         1500,
-        4,
-        '葱蒜类'
+        4
     ),
     (
         9,
@@ -186,8 +321,7 @@ VALUES (
         'https://placehold.co/300x300/800080/ffffff?text=Eggplant',
         3.80,
         600,
-        1,
-        '茄果类'
+        1
     ),
     (
         10,
@@ -196,8 +330,7 @@ VALUES (
         'https://placehold.co/300x300/228b22/ffffff?text=Chili',
         7.20,
         500,
-        1,
-        '茄果类'
+        1
     ),
     (
         11,
@@ -206,8 +339,7 @@ VALUES (
         'https://placehold.co/300x300/98fb98/006400?text=Zucchini',
         2.50,
         900,
-        2,
-        '瓜果类'
+        2
     ),
     (
         12,
@@ -216,8 +348,7 @@ VALUES (
         'https://placehold.co/300x300/fffacd/8b4513?text=Melon',
         12.80,
         200,
-        7,
-        '水果类'
+        7
     ),
     (
         13,
@@ -226,8 +357,7 @@ VALUES (
         'https://placehold.co/300x300/e9967a/ffffff?text=Pumpkin',
         4.20,
         700,
-        2,
-        '瓜果类'
+        2
     ),
     (
         14,
@@ -236,8 +366,7 @@ VALUES (
         'https://placehold.co/300x300/228b22/ffffff?text=Broccoli',
         6.80,
         400,
-        5,
-        '叶菜类'
+        5
     ),
     (
         15,
@@ -246,8 +375,7 @@ VALUES (
         'https://placehold.co/300x300/d2b48c/ffffff?text=Potato',
         2.20,
         3000,
-        3,
-        '根茎类'
+        3
     ),
     (
         16,
@@ -256,8 +384,7 @@ VALUES (
         'https://placehold.co/300x300/2f4f4f/ffffff?text=Melon',
         1.80,
         800,
-        2,
-        '瓜果类'
+        2
     ),
     (
         17,
@@ -266,8 +393,7 @@ VALUES (
         'https://placehold.co/300x300/00ff00/006400?text=Bitter',
         4.80,
         300,
-        2,
-        '瓜果类'
+        2
     ),
     (
         18,
@@ -276,8 +402,7 @@ VALUES (
         'https://placehold.co/300x300/f5deb3/8b4513?text=Yam',
         9.50,
         400,
-        3,
-        '根茎类'
+        3
     ),
     (
         19,
@@ -286,8 +411,7 @@ VALUES (
         'https://placehold.co/300x300/ffffff/000000?text=Radish',
         1.20,
         2500,
-        3,
-        '根茎类'
+        3
     ),
     (
         20,
@@ -296,8 +420,7 @@ VALUES (
         'https://placehold.co/300x300/fff5ee/deb887?text=Mushroom',
         3.50,
         600,
-        6,
-        '菌菇类'
+        6
     ),
     (
         21,
@@ -306,8 +429,7 @@ VALUES (
         'https://placehold.co/300x300/dcdcdc/696969?text=Mushroom',
         5.50,
         400,
-        6,
-        '菌菇类'
+        6
     ),
     (
         22,
@@ -316,8 +438,7 @@ VALUES (
         'https://placehold.co/300x300/ff0000/ffffff?text=CherryT',
         8.00,
         300,
-        1,
-        '茄果类'
+        1
     ),
     (
         23,
@@ -326,8 +447,7 @@ VALUES (
         'https://placehold.co/300x300/7cfc00/006400?text=Lettuce',
         4.00,
         500,
-        5,
-        '叶菜类'
+        5
     ),
     (
         24,
@@ -336,8 +456,7 @@ VALUES (
         'https://placehold.co/300x300/32cd32/ffffff?text=Leafy',
         3.00,
         600,
-        5,
-        '叶菜类'
+        5
     ),
     (
         25,
@@ -346,8 +465,7 @@ VALUES (
         'https://placehold.co/300x300/006400/ffffff?text=Asparagus',
         15.00,
         200,
-        5,
-        '叶菜类'
+        5
     ),
     (
         26,
@@ -356,8 +474,7 @@ VALUES (
         'https://placehold.co/300x300/228b22/ffffff?text=Okra',
         9.80,
         300,
-        1,
-        '茄果类'
+        1
     ),
     (
         27,
@@ -366,8 +483,7 @@ VALUES (
         'https://placehold.co/300x300/fff8dc/8b4513?text=Lotus',
         5.20,
         600,
-        3,
-        '根茎类'
+        3
     ),
     (
         28,
@@ -376,8 +492,7 @@ VALUES (
         'https://placehold.co/300x300/556b2f/ffffff?text=Pumpkin',
         6.00,
         500,
-        2,
-        '瓜果类'
+        2
     ),
     (
         29,
@@ -386,8 +501,7 @@ VALUES (
         'https://placehold.co/300x300/8fbc8f/ffffff?text=Bean',
         5.60,
         400,
-        8,
-        '豆类'
+        8
     ),
     (
         30,
@@ -396,8 +510,7 @@ VALUES (
         'https://placehold.co/300x300/00ff7f/ffffff?text=Bean',
         6.20,
         400,
-        8,
-        '豆类'
+        8
     ),
     (
         31,
@@ -406,8 +519,7 @@ VALUES (
         'https://placehold.co/300x300/fffff0/bdb76b?text=Cabbage',
         4.50,
         1000,
-        5,
-        '叶菜类'
+        5
     ),
     (
         32,
@@ -416,8 +528,7 @@ VALUES (
         'https://placehold.co/300x300/cd853f/ffffff?text=Potato',
         3.50,
         2000,
-        3,
-        '根茎类'
+        3
     );
 
 INSERT INTO
@@ -447,16 +558,22 @@ CREATE TABLE `orders` (
     `user_id` bigint(20) NOT NULL,
     `total_amount` float(10, 2) NOT NULL DEFAULT 0.00,
     `status` int(11) DEFAULT '0' COMMENT '0:未支付 1:已支付 2:已取消',
+    `goods_amount` decimal(10, 2) DEFAULT 0.00 COMMENT '商品金额',
+    `shipping_fee` decimal(10, 2) DEFAULT 0.00 COMMENT '运费',
+    `discount_amount` decimal(10, 2) DEFAULT 0.00 COMMENT '优惠金额',
     `address_id` bigint(20) DEFAULT NULL,
     `receiver_name` varchar(64) DEFAULT '',
     `receiver_mobile` varchar(20) DEFAULT '',
     `receiver_address` varchar(255) DEFAULT '',
+    `checkout_id` varchar(64) DEFAULT '' COMMENT '购物车结算快照',
+    `cart_cleanup_sent` tinyint(1) DEFAULT 0 COMMENT 'order.created 事件已投递',
     `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
     `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `deleted_at` datetime DEFAULT NULL,
     PRIMARY KEY (`id`),
     UNIQUE KEY `uni_order_no` (`order_no`),
-    KEY `idx_user_id` (`user_id`)
+    KEY `idx_user_id` (`user_id`),
+    KEY `idx_orders_checkout_id` (`checkout_id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
 CREATE TABLE `order_items` (
@@ -471,12 +588,44 @@ CREATE TABLE `order_items` (
     `quantity` int(11) DEFAULT NULL,
     `picture` varchar(255) DEFAULT NULL,
     `is_reviewed` tinyint(1) DEFAULT 0,
+    `discount_amount` decimal(10, 2) DEFAULT 0.00 COMMENT '分摊到该行的优惠金额',
     `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
     `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     KEY `idx_order_id` (`order_id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
+CREATE TABLE `order_discounts` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `order_id` bigint(20) NOT NULL,
+    `order_no` varchar(64) DEFAULT NULL,
+    `coupon_id` bigint(20) DEFAULT 0,
+    `user_coupon_id` bigint(20) DEFAULT 0,
+    `name` varchar(64) DEFAULT '',
+    `amount` decimal(10, 2) DEFAULT 0.00,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_order_discounts_order_id` (`order_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `shipping_rules` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `name` varchar(64) DEFAULT NULL,
+    `regions` varchar(1024) DEFAULT '' COMMENT '适用省份编码(逗号分隔)，为空表示全国默认',
+    `deliverable` tinyint(1) DEFAULT 1 COMMENT '0: 该区域不配送',
+    `mode` varchar(16) DEFAULT 'count' COMMENT 'count 按件 / weight 按重量(克)',
+    `first_unit` int(11) DEFAULT 0,
+    `first_fee` decimal(10, 2) DEFAULT 0.00,
+    `additional_unit` int(11) DEFAULT 0,
+    `additional_fee` decimal(10, 2) DEFAULT 0.00,
+    `free_threshold` decimal(10, 2) DEFAULT 0.00 COMMENT '满额包邮，0 表示不包邮',
+    `priority` int(11) DEFAULT 0,
+    `enabled` tinyint(1) DEFAULT 1,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
 -- =======================================================
 -- 4. 评价服务 (db_review)
 -- =======================================================
@@ -507,4 +656,93 @@ CREATE TABLE `reviews` (
     KEY `idx_product_id` (`product_id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
 
+-- =======================================================
+-- 5. 购物车服务 (db_cart)
+-- =======================================================
+DROP DATABASE IF EXISTS `db_cart`;
+
+CREATE DATABASE `db_cart` CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;
+
+USE `db_cart`;
+
+CREATE TABLE `carts` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `owner_key` varchar(64) NOT NULL COMMENT '与 Redis key 一致，如 cart:1 / cart:guest:{gid}',
+    `user_id` bigint(20) DEFAULT 0 COMMENT '游客购物车为 0',
+    `guest_id` varchar(32) DEFAULT '',
+    `item_count` int(11) DEFAULT 0,
+    `last_active_at` datetime DEFAULT NULL COMMENT '最后一次修改时间',
+    `abandoned_at` datetime DEFAULT NULL COMMENT '已发送 cart.abandoned 事件的时间',
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_carts_owner_key` (`owner_key`),
+    KEY `idx_carts_user_id` (`user_id`),
+    KEY `idx_carts_last_active_at` (`last_active_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `cart_items` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `cart_id` bigint(20) unsigned NOT NULL,
+    `sku_id` bigint(20) NOT NULL,
+    `quantity` int(11) DEFAULT 0,
+    `price` decimal(10, 2) DEFAULT 0.00 COMMENT '加入购物车时的价格',
+    `selected` tinyint(1) DEFAULT 1,
+    `added_at` bigint(20) DEFAULT 0,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_cart_sku` (`cart_id`, `sku_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+
+-- =======================================================
+-- 6. 营销服务 (db_promotion)
+-- =======================================================
+DROP DATABASE IF EXISTS `db_promotion`;
+
+CREATE DATABASE `db_promotion` CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;
+
+USE `db_promotion`;
+
+CREATE TABLE `coupons` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `name` varchar(64) NOT NULL,
+    `type` varchar(16) NOT NULL COMMENT 'fixed 满减 / percent 折扣',
+    `amount` decimal(10, 2) DEFAULT 0.00 COMMENT 'fixed: 减免金额',
+    `percent` int(11) DEFAULT 0 COMMENT 'percent: 85 表示 85 折',
+    `max_discount` decimal(10, 2) DEFAULT 0.00 COMMENT 'percent: 最多优惠金额，0 表示不限',
+    `threshold` decimal(10, 2) DEFAULT 0.00 COMMENT '适用商品金额门槛，0 表示无门槛',
+    `scope` varchar(16) DEFAULT 'all' COMMENT 'all / category / sku',
+    `scope_ids` varchar(1024) DEFAULT '' COMMENT '分类或 SKU ID，逗号分隔',
+    `total_quantity` int(11) DEFAULT 0 COMMENT '发行总量，0 表示不限',
+    `issued_quantity` int(11) DEFAULT 0,
+    `claim_limit` int(11) DEFAULT 0 COMMENT '每人限领，0 表示不限',
+    `use_limit` int(11) DEFAULT 0 COMMENT '每人限用，0 表示不限',
+    `start_at` datetime DEFAULT NULL,
+    `end_at` datetime DEFAULT NULL,
+    `valid_days` int(11) DEFAULT 0 COMMENT '领取后 N 天内有效，0 表示到 end_at 为止',
+    `enabled` tinyint(1) DEFAULT 1,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `user_coupons` (
+    `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` bigint(20) NOT NULL,
+    `coupon_id` bigint(20) unsigned NOT NULL,
+    `status` int(11) DEFAULT 0 COMMENT '0 未使用 1 已锁定 2 已使用',
+    `order_no` varchar(64) DEFAULT '' COMMENT '锁定 / 使用该券的订单',
+    `discount_amount` decimal(10, 2) DEFAULT 0.00 COMMENT '锁定时的优惠金额',
+    `claimed_at` datetime DEFAULT NULL,
+    `valid_until` datetime DEFAULT NULL,
+    `locked_at` datetime DEFAULT NULL,
+    `used_at` datetime DEFAULT NULL,
+    `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_user_coupons_user_id` (`user_id`),
+    KEY `idx_user_coupons_coupon_id` (`coupon_id`),
+    KEY `idx_user_coupons_order_no` (`order_no`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
 SET FOREIGN_KEY_CHECKS = 1;
\ No newline at end of file
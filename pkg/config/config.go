@@ -11,6 +11,15 @@ type Config struct {
 	Consul  ConsulConfig  `mapstructure:"consul"`
 	Mysql   MysqlConfig   `mapstructure:"mysql"`
 	Redis   RedisConfig   `mapstructure:"redis"`
+
+	Password PasswordConfig `mapstructure:"password"`
+	Notify   NotifyConfig   `mapstructure:"notify"`
+	OAuth    OAuthConfig    `mapstructure:"oauth"`
+
+	Address AddressConfig `mapstructure:"address"`
+	Cart    CartConfig    `mapstructure:"cart"`
+	Order   OrderConfig   `mapstructure:"order"`
+	Search  SearchConfig  `mapstructure:"search"`
 }
 
 type ServiceConfig struct {
@@ -36,6 +45,72 @@ type RedisConfig struct {
 	Db       int    `mapstructure:"db"`
 }
 
+// PasswordConfig 密码策略与找回密码配置 (User Service 使用)
+type PasswordConfig struct {
+	BcryptCost     int  `mapstructure:"bcrypt_cost"`
+	MinLength      int  `mapstructure:"min_length"`
+	MaxLength      int  `mapstructure:"max_length"`
+	RequireLetter  bool `mapstructure:"require_letter"`
+	RequireDigit   bool `mapstructure:"require_digit"`
+	RequireUpper   bool `mapstructure:"require_upper"`
+	RequireSpecial bool `mapstructure:"require_special"`
+	ResetTokenTTL  int  `mapstructure:"reset_token_ttl"` // 重置令牌有效期 (分钟)
+}
+
+// NotifyConfig 消息投递配置
+type NotifyConfig struct {
+	Channel string `mapstructure:"channel"` // log (默认)
+}
+
+// OAuthConfig 第三方登录配置
+type OAuthConfig struct {
+	StateSecret string                `mapstructure:"state_secret"`
+	StateTTL    int                   `mapstructure:"state_ttl"` // state 有效期 (秒)
+	Providers   []OAuthProviderConfig `mapstructure:"providers"`
+}
+
+// OAuthProviderConfig 单个 OIDC 身份提供方
+type OAuthProviderConfig struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// AddressConfig 收货地址配置 (Address Service 使用)
+type AddressConfig struct {
+	RegionFile string `mapstructure:"region_file"`  // 行政区划数据文件，为空时使用内置数据集
+	MaxPerUser int    `mapstructure:"max_per_user"` // 每个用户最多保存的地址数
+}
+
+// CartConfig 购物车配置 (Cart Service 与 Gateway 使用)
+type CartConfig struct {
+	MaxQuantityPerSku int    `mapstructure:"max_quantity_per_sku"` // 单个商品最多购买件数
+	MaxItems          int    `mapstructure:"max_items"`            // 购物车最多商品种类数
+	GuestTTL          int    `mapstructure:"guest_ttl"`            // 游客购物车闲置过期时间 (小时)
+	GuestSecret       string `mapstructure:"guest_secret"`         // 游客购物车 Cookie 签名密钥 (Gateway)
+	AbandonAfter      int    `mapstructure:"abandon_after"`        // 购物车闲置多久后发送 cart.abandoned 事件 (分钟)
+}
+
+// OrderConfig 订单配置 (Order Service 使用)
+type OrderConfig struct {
+	PriceTokenSecret string `mapstructure:"price_token_secret"` // 订单确认价格令牌签名密钥
+	PriceTokenTTL    int    `mapstructure:"price_token_ttl"`    // 价格令牌有效期 (分钟)
+}
+
+// SearchConfig 商品搜索配置 (Product Service 使用)
+type SearchConfig struct {
+	Index    string   `mapstructure:"index"`    // 索引别名，默认 products
+	Analyzer string   `mapstructure:"analyzer"` // 中文分词：ik / smartcn / cjk，默认 ik，插件未安装时退回 cjk
+	Synonyms []string `mapstructure:"synonyms"` // 同义词 (Solr 格式)，为空使用内置果蔬同义词
+	Pinyin   bool     `mapstructure:"pinyin"`   // 拼音检索，需安装 analysis-pinyin 插件
+	Fuzzy    bool     `mapstructure:"fuzzy"`    // 关键词模糊匹配
+
+	HealthInterval int `mapstructure:"health_interval"` // ES 健康检查与重连间隔 (秒)，默认 10
+}
+
 // LoadConfig 读取配置文件
 func LoadConfig(path string) (*Config, error) {
 	viper.AddConfigPath(path)
@@ -0,0 +1,23 @@
+// Package events 服务之间通过 RabbitMQ 传递的事件，发布方与订阅方共用同一份定义
+package events
+
+// 订单事件 (topic 交换机，其他服务按路由 Key 订阅)
+const (
+	OrderEventExchange  = "order.events"
+	OrderCreatedRouting = "order.created"
+)
+
+// OrderCreatedEvent order.created 事件：订单提交成功
+// Cart Service 据此从购物车移除结算快照中已下单的商品
+type OrderCreatedEvent struct {
+	OrderNo    string             `json:"order_no"`
+	UserID     int64              `json:"user_id"`
+	CheckoutID string             `json:"checkout_id"`
+	Items      []OrderCreatedItem `json:"items"`
+}
+
+// OrderCreatedItem 已下单的商品与数量
+type OrderCreatedItem struct {
+	SkuID    int64 `json:"sku_id"`
+	Quantity int   `json:"quantity"`
+}
@@ -0,0 +1,213 @@
+// Package money 金额统一以最小货币单位 (分) 的 int64 表示
+//
+// 系统只支持人民币单一币种，金额不携带币种信息。
+// 服务之间 (proto) 只传递整数分，数据库仍使用 decimal(10,2)，
+// Cents 实现了 sql.Scanner / driver.Valuer，在读写数据库时按十进制字符串精确转换，
+// 不经过浮点数。涉及比例的运算统一使用本包的舍入规则：
+//   - MulRatio：按万分比调价、按百分比打折，结果四舍五入到分 (0.5 分远离 0 进位)
+//   - Allocate：按权重分摊 (优惠分摊到商品行)，各份向下取整，剩余的分按余数从大到小逐份补 1 分，
+//     余数相同时靠前的优先，保证分摊之和等于总额且每份不超过按比例的上取整
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RatioBase MulRatio 的比例基数：10000 表示 100%
+const RatioBase = 10000
+
+// ErrInvalid 无法解析的金额
+var ErrInvalid = errors.New("金额格式错误")
+
+// Cents 金额，单位为分
+type Cents int64
+
+// String 以元为单位的十进制表示，固定两位小数，如 "12.34"、"-0.05"
+func (c Cents) String() string {
+	sign := ""
+	v := int64(c)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// Yuan 转为以元为单位的浮点数，仅用于展示或与外部系统对接，不得再参与金额计算
+func (c Cents) Yuan() float64 {
+	return float64(c) / 100
+}
+
+// Mul 单价乘以数量
+func (c Cents) Mul(quantity int64) Cents {
+	return c * Cents(quantity)
+}
+
+// MulRatio 按比例换算：c * num / den，四舍五入到分 (0.5 分远离 0 进位)
+// 用 big.Int 计算中间结果，避免大额乘法溢出
+func (c Cents) MulRatio(num, den int64) Cents {
+	if den == 0 {
+		panic("money: MulRatio 分母为 0")
+	}
+	p := new(big.Int).Mul(big.NewInt(int64(c)), big.NewInt(num))
+	d := big.NewInt(den)
+	neg := p.Sign()*d.Sign() < 0
+	p.Abs(p)
+	d.Abs(d)
+	q, r := new(big.Int).QuoRem(p, d, new(big.Int))
+	if r.Lsh(r, 1).Cmp(d) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if neg {
+		q.Neg(q)
+	}
+	return Cents(q.Int64())
+}
+
+// Allocate 将 total 按 weights 的比例分摊，返回与 weights 等长的结果
+// 规则见包注释；权重之和为 0 时全部分摊为 0
+func Allocate(total Cents, weights []Cents) []Cents {
+	out := make([]Cents, len(weights))
+	var sum int64
+	for _, w := range weights {
+		sum += int64(w)
+	}
+	if sum <= 0 || total == 0 {
+		return out
+	}
+
+	rems := make([]*big.Int, len(weights))
+	var assigned Cents
+	for i, w := range weights {
+		p := new(big.Int).Mul(big.NewInt(int64(total)), big.NewInt(int64(w)))
+		q, r := new(big.Int).QuoRem(p, big.NewInt(sum), new(big.Int))
+		out[i] = Cents(q.Int64())
+		rems[i] = r
+		assigned += out[i]
+	}
+	for left := total - assigned; left > 0; left-- {
+		best := -1
+		for i, r := range rems {
+			if r.Sign() > 0 && (best < 0 || r.Cmp(rems[best]) > 0) {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		out[best]++
+		rems[best].SetInt64(0)
+	}
+	return out
+}
+
+// Parse 解析以元为单位的十进制字符串，如 "12.34"、"12"、"-0.5"
+// 超过两位小数时四舍五入到分
+func Parse(s string) (Cents, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalid
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	intPart, frac, _ := strings.Cut(s, ".")
+	if intPart == "" && frac == "" {
+		return 0, ErrInvalid
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	// 符号已在上面处理，整数与小数部分只允许数字 (ParseInt 会接受 "--1" 中剩下的 "-1")
+	if !digits(intPart) || !digits(frac) {
+		return 0, ErrInvalid
+	}
+	yuan, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || yuan > maxYuan {
+		return 0, ErrInvalid
+	}
+	roundUp := len(frac) > 2 && frac[2] >= '5'
+	frac = (frac + "00")[:2]
+	fen, _ := strconv.ParseInt(frac, 10, 64)
+	if roundUp {
+		fen++
+	}
+	if yuan*100 > math.MaxInt64-fen {
+		return 0, ErrInvalid
+	}
+
+	v := yuan*100 + fen
+	if neg {
+		v = -v
+	}
+	return Cents(v), nil
+}
+
+// maxYuan 可以表示为 Cents 的最大整数元
+const maxYuan = math.MaxInt64 / 100
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FromYuan 由以元为单位的浮点数转换，四舍五入到分；仅用于兼容外部输入
+func FromYuan(f float64) Cents {
+	return Cents(math.Round(f * 100))
+}
+
+// Scan 实现 sql.Scanner：读取 decimal 列 (驱动返回 []byte / string)
+//
+// 与写入一致，数据库中的金额一律以元为单位：驱动返回整数 (int64，如整数类型的列或表达式) 时
+// 视为整数元并乘以 100，不是分；返回浮点数 (float64) 时同样视为元，四舍五入到分
+func (c *Cents) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*c = 0
+		return nil
+	case []byte:
+		return c.scanString(string(v))
+	case string:
+		return c.scanString(v)
+	case int64:
+		if v > maxYuan || v < -maxYuan {
+			return fmt.Errorf("money: 金额 %d 元超出范围", v)
+		}
+		*c = Cents(v * 100)
+		return nil
+	case float64:
+		*c = FromYuan(v)
+		return nil
+	default:
+		return fmt.Errorf("money: 不支持的数据库类型 %T", src)
+	}
+}
+
+func (c *Cents) scanString(s string) error {
+	v, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("money: 无法解析 %q: %w", s, err)
+	}
+	*c = v
+	return nil
+}
+
+// Value 实现 driver.Valuer：以十进制字符串写入 decimal 列
+func (c Cents) Value() (driver.Value, error) {
+	return c.String(), nil
+}
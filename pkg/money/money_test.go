@@ -0,0 +1,201 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMulRatio(t *testing.T) {
+	cases := []struct {
+		name     string
+		c        Cents
+		num, den int64
+		want     Cents
+	}{
+		{"整除", 1000, 85, 100, 850},
+		{"0.5 分进位", 1, 1, 2, 1},
+		{"不足 0.5 分舍去", 1, 1, 3, 0},
+		{"超过 0.5 分进位", 2, 1, 3, 1},
+		{"1.4985 元进位为 1.50", 999, 15, 100, 150},
+		{"1.5045 元舍去为 1.50", 1003, 15, 100, 150},
+		{"万分比调价", 1999, 10500, RatioBase, 2099}, // 20.9895
+		// 负数按绝对值舍入后取反 (0.5 分远离 0)
+		{"负金额 0.5 分", -1, 1, 2, -1},
+		{"负金额不足 0.5 分", -1, 1, 3, 0},
+		{"负比例", 1000, -15, 100, -150},
+		{"负比例 0.5 分", 5, -1, 10, -1},
+		{"负分母", 5, 1, -10, -1},
+		{"负负得正", -5, -1, 10, 1},
+		{"零", 0, 85, 100, 0},
+		// 中间结果超过 int64 也不溢出
+		{"大额", math.MaxInt64 / 2, 3, 4, 3458764513820540927},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.c.MulRatio(c.num, c.den); got != c.want {
+				t.Fatalf("%d * %d / %d 期望 %d，实际 %d", c.c, c.num, c.den, c.want, got)
+			}
+		})
+	}
+}
+
+func TestMulRatioZeroDenominator(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("分母为 0 时应 panic")
+		}
+	}()
+	Cents(100).MulRatio(1, 0)
+}
+
+func TestAllocate(t *testing.T) {
+	cases := []struct {
+		name    string
+		total   Cents
+		weights []Cents
+		want    []Cents
+	}{
+		{"整除", 100, []Cents{100, 300}, []Cents{25, 75}},
+		{"余数补给较大的一份", 100, []Cents{100, 200}, []Cents{33, 67}},
+		{"三等分余数给靠前的", 100, []Cents{1, 1, 1}, []Cents{34, 33, 33}},
+		{"余 2 分", 200, []Cents{1, 1, 1}, []Cents{67, 67, 66}},
+		{"余数从大到小", 10, []Cents{3, 3, 4}, []Cents{3, 3, 4}},
+		{"多份余数排序", 7, []Cents{1, 2, 3}, []Cents{1, 2, 4}}, // 1.17 / 2.33 / 3.5
+		{"零权重不分摊", 100, []Cents{0, 50, 50}, []Cents{0, 50, 50}},
+		{"权重之和为 0", 100, []Cents{0, 0}, []Cents{0, 0}},
+		{"总额为 0", 0, []Cents{1, 2}, []Cents{0, 0}},
+		{"单份", 123, []Cents{999}, []Cents{123}},
+		{"无权重", 100, nil, []Cents{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Allocate(c.total, c.weights)
+			if len(got) != len(c.want) {
+				t.Fatalf("期望 %v，实际 %v", c.want, got)
+			}
+			var sum, weights Cents
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Fatalf("期望 %v，实际 %v", c.want, got)
+				}
+				sum += got[i]
+				weights += c.weights[i]
+			}
+			if weights > 0 && sum != c.total {
+				t.Fatalf("分摊之和 %d 不等于总额 %d", sum, c.total)
+			}
+		})
+	}
+}
+
+// 任意权重下分摊之和都等于总额，且每份不超过按比例的上取整
+func TestAllocateSumPreserved(t *testing.T) {
+	weights := []Cents{1999, 1, 333, 4500, 7, 0, 12345}
+	var sum int64
+	for _, w := range weights {
+		sum += int64(w)
+	}
+	for total := Cents(0); total <= 1000; total++ {
+		got := Allocate(total, weights)
+		var s Cents
+		for i, v := range got {
+			s += v
+			ceil := (int64(total)*int64(weights[i]) + sum - 1) / sum
+			if v < 0 || int64(v) > ceil {
+				t.Fatalf("total=%d 第 %d 份为 %d，超出范围 [0, %d]", total, i, v, ceil)
+			}
+		}
+		if s != total {
+			t.Fatalf("total=%d 分摊之和为 %d", total, s)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Cents
+	}{
+		{"12.34", 1234},
+		{"12", 1200},
+		{"12.", 1200},
+		{".5", 50},
+		{"0.05", 5},
+		{"-0.5", -50},
+		{"+3.1", 310},
+		{" 7.00 ", 700},
+		{"1.005", 101}, // 第三位小数四舍五入
+		{"1.004", 100},
+		{"0.995", 100},
+		{"-1.005", -101},
+		{"00012.30", 1230},
+	}
+	for _, c := range cases {
+		got, err := Parse(c.in)
+		if err != nil || got != c.want {
+			t.Errorf("Parse(%q) 期望 %d，实际 %d (%v)", c.in, c.want, got, err)
+		}
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	for _, in := range []string{
+		"", " ", "-", "+", ".", "-.", "abc", "1a", "1.2a", "1.2.3", "1,000.00",
+		"--1", "+-1", "-+1", "1e3", "0x10", "1 000", "1.-5", "1.+5", "¥12",
+		"92233720368547758.08", "99999999999999999999",
+	} {
+		if got, err := Parse(in); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q) 期望 ErrInvalid，实际 %d (%v)", in, got, err)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name string
+		src  interface{}
+		want Cents
+	}{
+		{"nil", nil, 0},
+		{"decimal 字节", []byte("12.34"), 1234},
+		{"decimal 字符串", "-0.05", -5},
+		{"整数按元", int64(12), 1200},
+		{"浮点按元", 12.345, 1235},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v := Cents(99)
+			if err := v.Scan(c.src); err != nil {
+				t.Fatal(err)
+			}
+			if v != c.want {
+				t.Fatalf("期望 %d，实际 %d", c.want, v)
+			}
+		})
+	}
+}
+
+func TestScanMalformed(t *testing.T) {
+	for _, src := range []interface{}{
+		[]byte("12.3x"), []byte(""), "--1", "1.2.3", int64(math.MaxInt64), int32(1), true,
+	} {
+		v := Cents(99)
+		if err := v.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) 期望返回错误，实际 %d", src, v)
+		}
+	}
+}
+
+func TestValueRoundTrip(t *testing.T) {
+	for _, c := range []Cents{0, 5, -5, 1234, -100, math.MaxInt64} {
+		v, err := c.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Cents
+		if err := got.Scan(v); err != nil || got != c {
+			t.Errorf("%d 写入为 %v 后读取为 %d (%v)", c, v, got, err)
+		}
+	}
+}
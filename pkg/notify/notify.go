@@ -0,0 +1,31 @@
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// Sender 消息投递接口 (短信/邮件/站内信等)
+// 各服务只依赖该接口，具体渠道通过配置选择实现
+type Sender interface {
+	Send(ctx context.Context, to, subject, content string) error
+}
+
+// LogSender 将消息打印到日志，开发环境下代替真实的短信/邮件通道
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, to, subject, content string) error {
+	log.Printf("[Notify] To=%s Subject=%s Content=%s", to, subject, content)
+	return nil
+}
+
+// NewSender 根据渠道名称创建 Sender，未知渠道降级为日志输出
+func NewSender(channel string) Sender {
+	switch channel {
+	case "", "log":
+		return LogSender{}
+	default:
+		log.Printf("[Notify] 未知的投递渠道 %q，降级为日志输出", channel)
+		return LogSender{}
+	}
+}
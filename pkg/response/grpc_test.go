@@ -0,0 +1,49 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cases := []struct {
+		err  error
+		want int
+	}{
+		{status.Error(codes.InvalidArgument, "参数错误"), http.StatusBadRequest},
+		{status.Error(codes.Unauthenticated, "未登录"), http.StatusUnauthorized},
+		{status.Error(codes.PermissionDenied, "无权限"), http.StatusForbidden},
+		{status.Error(codes.NotFound, "商品不存在"), http.StatusNotFound},
+		{status.Error(codes.FailedPrecondition, "库存不足"), http.StatusConflict},
+		{status.Error(codes.AlreadyExists, "已存在"), http.StatusConflict},
+		{status.Error(codes.ResourceExhausted, "超过上限"), http.StatusTooManyRequests},
+		{status.Error(codes.Unavailable, "服务不可用"), http.StatusServiceUnavailable},
+		{status.Error(codes.DeadlineExceeded, "超时"), http.StatusGatewayTimeout},
+		{status.Error(codes.Internal, "内部错误"), http.StatusInternalServerError},
+		{status.Error(codes.Unknown, "未知错误"), http.StatusInternalServerError},
+		{errors.New("非 gRPC 错误"), http.StatusInternalServerError},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		GRPCError(ctx, c.err)
+		if w.Code != c.want {
+			t.Errorf("%v: 期望 HTTP %d，实际 %d", c.err, c.want, w.Code)
+		}
+		var body Response
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Code != c.want || body.Msg != status.Convert(c.err).Message() {
+			t.Errorf("%v: 响应体不正确 %+v", c.err, body)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 中国大陆手机号：1 开头，第二位 3-9，共 11 位
+var mobilePattern = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// NormalizeMobile 去除空格、短横线与 +86/0086 前缀，并校验手机号格式
+// 返回规范化后的 11 位号码；格式不合法时 ok 为 false
+func NormalizeMobile(mobile string) (normalized string, ok bool) {
+	m := strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(mobile))
+	switch {
+	case strings.HasPrefix(m, "+86"):
+		m = m[3:]
+	case strings.HasPrefix(m, "0086"):
+		m = m[4:]
+	}
+	if !mobilePattern.MatchString(m) {
+		return "", false
+	}
+	return m, true
+}
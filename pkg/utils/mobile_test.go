@@ -0,0 +1,35 @@
+package utils
+
+import "testing"
+
+func TestNormalizeMobile(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string // 为空表示格式不合法
+	}{
+		{"13812345678", "13812345678"},
+		{" 13812345678 ", "13812345678"},
+		{"138 1234 5678", "13812345678"},
+		{"138-1234-5678", "13812345678"},
+		{"+8613812345678", "13812345678"},
+		{"+86 138 1234 5678", "13812345678"},
+		{"008613812345678", "13812345678"},
+		{"19912345678", "19912345678"},
+		{"", ""},
+		{"1381234567", ""},   // 10 位
+		{"138123456789", ""}, // 12 位
+		{"12812345678", ""},  // 第二位不能是 0-2
+		{"23812345678", ""},  // 必须以 1 开头
+		{"1381234567a", ""},
+		{"+85213812345678", ""}, // 非大陆区号
+		{"8613812345678", ""},   // 缺少 + 的区号不去除
+		{"+86+8613812345678", ""},
+		{"１３８１２３４５６７８", ""}, // 全角数字
+	}
+	for _, c := range cases {
+		got, ok := NormalizeMobile(c.in)
+		if ok != (c.want != "") || got != c.want {
+			t.Errorf("NormalizeMobile(%q) = %q, %v；期望 %q", c.in, got, ok, c.want)
+		}
+	}
+}
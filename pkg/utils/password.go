@@ -1,10 +1,29 @@
 package utils
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// hashCost bcrypt 加密强度，默认使用 bcrypt.DefaultCost
+var hashCost = bcrypt.DefaultCost
+
+// SetHashCost 设置 bcrypt 加密强度 (取值范围 4~31)
+func SetHashCost(cost int) error {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return fmt.Errorf("bcrypt cost 必须在 %d~%d 之间", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	hashCost = cost
+	return nil
+}
 
 // HashPassword 密码加密
 func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), hashCost)
 	return string(bytes), err
 }
 
@@ -13,3 +32,65 @@ func CheckPassword(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// PasswordPolicy 密码强度策略
+type PasswordPolicy struct {
+	MinLength      int  // 最小长度
+	MaxLength      int  // 最大长度 (bcrypt 只处理前 72 字节)
+	RequireLetter  bool // 必须包含字母
+	RequireDigit   bool // 必须包含数字
+	RequireUpper   bool // 必须包含大写字母
+	RequireSpecial bool // 必须包含特殊字符
+}
+
+// DefaultPasswordPolicy 默认密码策略：8~72 位，字母 + 数字
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:     8,
+	MaxLength:     72,
+	RequireLetter: true,
+	RequireDigit:  true,
+}
+
+// Validate 校验密码是否满足策略，不满足时返回可直接展示给用户的错误
+func (p PasswordPolicy) Validate(password string) error {
+	if password == "" {
+		return errors.New("密码不能为空")
+	}
+	n := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && n < p.MinLength {
+		return fmt.Errorf("密码长度不能少于 %d 位", p.MinLength)
+	}
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		return fmt.Errorf("密码长度不能超过 %d 位", p.MaxLength)
+	}
+
+	var hasLetter, hasDigit, hasUpper, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsSpace(r):
+			return errors.New("密码不能包含空白字符")
+		case unicode.IsUpper(r):
+			hasUpper, hasLetter = true, true
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireLetter && !hasLetter {
+		return errors.New("密码必须包含字母")
+	}
+	if p.RequireDigit && !hasDigit {
+		return errors.New("密码必须包含数字")
+	}
+	if p.RequireUpper && !hasUpper {
+		return errors.New("密码必须包含大写字母")
+	}
+	if p.RequireSpecial && !hasSpecial {
+		return errors.New("密码必须包含特殊字符")
+	}
+	return nil
+}
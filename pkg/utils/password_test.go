@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	strict := PasswordPolicy{MinLength: 8, MaxLength: 72, RequireLetter: true, RequireDigit: true, RequireUpper: true, RequireSpecial: true}
+
+	cases := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		wantErr  string // 为空表示应通过
+	}{
+		{"默认策略通过", DefaultPasswordPolicy, "abc12345", ""},
+		{"空密码", DefaultPasswordPolicy, "", "密码不能为空"},
+		{"过短", DefaultPasswordPolicy, "abc1234", "密码长度不能少于 8 位"},
+		{"恰好 72 字节", DefaultPasswordPolicy, strings.Repeat("a", 71) + "1", ""},
+		{"超过 72 字节", DefaultPasswordPolicy, strings.Repeat("a", 72) + "1", "密码长度不能超过 72 位"},
+		// 长度下限按字符数、上限按字节数计算
+		{"中文按字符计长度", DefaultPasswordPolicy, "密码密码密码a1", ""},
+		{"中文超出字节上限", DefaultPasswordPolicy, strings.Repeat("密", 24) + "a1", "密码长度不能超过 72 位"},
+		{"包含空格", DefaultPasswordPolicy, "abc 12345", "密码不能包含空白字符"},
+		{"包含制表符", DefaultPasswordPolicy, "abc\t12345", "密码不能包含空白字符"},
+		{"缺少字母", DefaultPasswordPolicy, "12345678", "密码必须包含字母"},
+		{"缺少数字", DefaultPasswordPolicy, "abcdefgh", "密码必须包含数字"},
+		{"大写字母也算字母", DefaultPasswordPolicy, "ABCD1234", ""},
+		{"严格策略通过", strict, "Abcd123!", ""},
+		{"缺少大写字母", strict, "abcd123!", "密码必须包含大写字母"},
+		{"缺少特殊字符", strict, "Abcd1234", "密码必须包含特殊字符"},
+		{"不限长度", PasswordPolicy{}, "a", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.policy.Validate(c.password)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("期望通过，实际 %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != c.wantErr {
+				t.Fatalf("期望错误 %q，实际 %v", c.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSetHashCost(t *testing.T) {
+	defer func(old int) { hashCost = old }(hashCost)
+
+	for _, cost := range []int{bcrypt.MinCost - 1, bcrypt.MaxCost + 1, 0, -1} {
+		if err := SetHashCost(cost); err == nil {
+			t.Errorf("cost=%d 期望返回错误", cost)
+		}
+	}
+	if hashCost != bcrypt.DefaultCost {
+		t.Fatalf("非法 cost 不应修改设置，实际 %d", hashCost)
+	}
+
+	if err := SetHashCost(bcrypt.MinCost); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := HashPassword("abc12345")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost, err := bcrypt.Cost([]byte(hash)); err != nil || cost != bcrypt.MinCost {
+		t.Fatalf("期望 cost=%d，实际 %d (%v)", bcrypt.MinCost, cost, err)
+	}
+	if !CheckPassword("abc12345", hash) || CheckPassword("abc12346", hash) {
+		t.Fatal("密码比对结果不正确")
+	}
+}
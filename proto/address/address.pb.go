@@ -33,6 +33,10 @@ type AddressInfo struct {
 	District      string                 `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`                                // 区
 	DetailAddress string                 `protobuf:"bytes,8,opt,name=detail_address,json=detailAddress,proto3" json:"detail_address,omitempty"` // 详细地址
 	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`            // 新增：是否默认地址
+	ProvinceCode  string                 `protobuf:"bytes,10,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`   // 行政区划编码 (GB/T 2260)，字典未收录的级别为空
+	CityCode      string                 `protobuf:"bytes,11,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
+	DistrictCode  string                 `protobuf:"bytes,12,opt,name=district_code,json=districtCode,proto3" json:"district_code,omitempty"`
+	Deleted       bool                   `protobuf:"varint,13,opt,name=deleted,proto3" json:"deleted,omitempty"` // 已被用户删除 (仅 include_deleted 查询时可能为 true)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -130,6 +134,34 @@ func (x *AddressInfo) GetIsDefault() bool {
 	return false
 }
 
+func (x *AddressInfo) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 type CreateAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -139,6 +171,10 @@ type CreateAddressRequest struct {
 	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
 	District      string                 `protobuf:"bytes,6,opt,name=district,proto3" json:"district,omitempty"`
 	DetailAddress string                 `protobuf:"bytes,7,opt,name=detail_address,json=detailAddress,proto3" json:"detail_address,omitempty"`
+	// 行政区划编码，与名称至少提供其一；两者都提供时必须一致
+	ProvinceCode  string `protobuf:"bytes,8,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	CityCode      string `protobuf:"bytes,9,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
+	DistrictCode  string `protobuf:"bytes,10,opt,name=district_code,json=districtCode,proto3" json:"district_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +258,27 @@ func (x *CreateAddressRequest) GetDetailAddress() string {
 	return ""
 }
 
+func (x *CreateAddressRequest) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
 type CreateAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AddressId     int64                  `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
@@ -355,10 +412,12 @@ func (x *ListAddressResponse) GetAddresses() []*AddressInfo {
 }
 
 type GetAddressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressId     int64                  `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AddressId      int64                  `protobuf:"varint,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // 必填，只能查询本人的地址
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // 是否包含已删除的地址 (用于回溯历史订单)，下单时不应设置
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetAddressRequest) Reset() {
@@ -398,6 +457,20 @@ func (x *GetAddressRequest) GetAddressId() int64 {
 	return 0
 }
 
+func (x *GetAddressRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAddressRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       *AddressInfo           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 引用 AddressInfo
@@ -452,6 +525,9 @@ type UpdateAddressRequest struct {
 	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
 	District      string                 `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`
 	DetailAddress string                 `protobuf:"bytes,8,opt,name=detail_address,json=detailAddress,proto3" json:"detail_address,omitempty"`
+	ProvinceCode  string                 `protobuf:"bytes,9,opt,name=province_code,json=provinceCode,proto3" json:"province_code,omitempty"`
+	CityCode      string                 `protobuf:"bytes,10,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
+	DistrictCode  string                 `protobuf:"bytes,11,opt,name=district_code,json=districtCode,proto3" json:"district_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -542,6 +618,27 @@ func (x *UpdateAddressRequest) GetDetailAddress() string {
 	return ""
 }
 
+func (x *UpdateAddressRequest) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
 type UpdateAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -778,11 +875,255 @@ func (x *SetDefaultAddressResponse) GetSuccess() bool {
 	return false
 }
 
+type PurgeUserAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserAddressesRequest) Reset() {
+	*x = PurgeUserAddressesRequest{}
+	mi := &file_proto_address_address_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserAddressesRequest) ProtoMessage() {}
+
+func (x *PurgeUserAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserAddressesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeUserAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PurgeUserAddressesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type PurgeUserAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int64                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserAddressesResponse) Reset() {
+	*x = PurgeUserAddressesResponse{}
+	mi := &file_proto_address_address_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserAddressesResponse) ProtoMessage() {}
+
+func (x *PurgeUserAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserAddressesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeUserAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PurgeUserAddressesResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type RegionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                // 1 省 2 市 3 区县
+	HasChildren   bool                   `protobuf:"varint,4,opt,name=has_children,json=hasChildren,proto3" json:"has_children,omitempty"` // 是否还有下级 (级联选择器据此判断是否继续展开)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionInfo) Reset() {
+	*x = RegionInfo{}
+	mi := &file_proto_address_address_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionInfo) ProtoMessage() {}
+
+func (x *RegionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionInfo.ProtoReflect.Descriptor instead.
+func (*RegionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RegionInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RegionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegionInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *RegionInfo) GetHasChildren() bool {
+	if x != nil {
+		return x.HasChildren
+	}
+	return false
+}
+
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentCode    string                 `protobuf:"bytes,1,opt,name=parent_code,json=parentCode,proto3" json:"parent_code,omitempty"` // 为空时返回全部省份
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_proto_address_address_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRegionsRequest) GetParentCode() string {
+	if x != nil {
+		return x.ParentCode
+	}
+	return ""
+}
+
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*RegionInfo          `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_proto_address_address_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_address_address_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_address_address_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRegionsResponse) GetRegions() []*RegionInfo {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
 var File_proto_address_address_proto protoreflect.FileDescriptor
 
 const file_proto_address_address_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/address/address.proto\x12\aaddress\"\xf4\x01\n" +
+	"\x1bproto/address/address.proto\x12\aaddress\"\xf5\x02\n" +
 	"\vAddressInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
@@ -793,7 +1134,12 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"\bdistrict\x18\a \x01(\tR\bdistrict\x12%\n" +
 	"\x0edetail_address\x18\b \x01(\tR\rdetailAddress\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\t \x01(\bR\tisDefault\"\xce\x01\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\x12#\n" +
+	"\rprovince_code\x18\n" +
+	" \x01(\tR\fprovinceCode\x12\x1b\n" +
+	"\tcity_code\x18\v \x01(\tR\bcityCode\x12#\n" +
+	"\rdistrict_code\x18\f \x01(\tR\fdistrictCode\x12\x18\n" +
+	"\adeleted\x18\r \x01(\bR\adeleted\"\xb5\x02\n" +
 	"\x14CreateAddressRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -801,19 +1147,25 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"\bprovince\x18\x04 \x01(\tR\bprovince\x12\x12\n" +
 	"\x04city\x18\x05 \x01(\tR\x04city\x12\x1a\n" +
 	"\bdistrict\x18\x06 \x01(\tR\bdistrict\x12%\n" +
-	"\x0edetail_address\x18\a \x01(\tR\rdetailAddress\"6\n" +
+	"\x0edetail_address\x18\a \x01(\tR\rdetailAddress\x12#\n" +
+	"\rprovince_code\x18\b \x01(\tR\fprovinceCode\x12\x1b\n" +
+	"\tcity_code\x18\t \x01(\tR\bcityCode\x12#\n" +
+	"\rdistrict_code\x18\n" +
+	" \x01(\tR\fdistrictCode\"6\n" +
 	"\x15CreateAddressResponse\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\x03R\taddressId\"-\n" +
 	"\x12ListAddressRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"I\n" +
 	"\x13ListAddressResponse\x122\n" +
-	"\taddresses\x18\x01 \x03(\v2\x14.address.AddressInfoR\taddresses\"2\n" +
+	"\taddresses\x18\x01 \x03(\v2\x14.address.AddressInfoR\taddresses\"t\n" +
 	"\x11GetAddressRequest\x12\x1d\n" +
 	"\n" +
-	"address_id\x18\x01 \x01(\x03R\taddressId\"D\n" +
+	"address_id\x18\x01 \x01(\x03R\taddressId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"D\n" +
 	"\x12GetAddressResponse\x12.\n" +
-	"\aaddress\x18\x01 \x01(\v2\x14.address.AddressInfoR\aaddress\"\xde\x01\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.address.AddressInfoR\aaddress\"\xc5\x02\n" +
 	"\x14UpdateAddressRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
@@ -822,7 +1174,11 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"\bprovince\x18\x05 \x01(\tR\bprovince\x12\x12\n" +
 	"\x04city\x18\x06 \x01(\tR\x04city\x12\x1a\n" +
 	"\bdistrict\x18\a \x01(\tR\bdistrict\x12%\n" +
-	"\x0edetail_address\x18\b \x01(\tR\rdetailAddress\"1\n" +
+	"\x0edetail_address\x18\b \x01(\tR\rdetailAddress\x12#\n" +
+	"\rprovince_code\x18\t \x01(\tR\fprovinceCode\x12\x1b\n" +
+	"\tcity_code\x18\n" +
+	" \x01(\tR\bcityCode\x12#\n" +
+	"\rdistrict_code\x18\v \x01(\tR\fdistrictCode\"1\n" +
 	"\x15UpdateAddressResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
 	"\x14DeleteAddressRequest\x12\x1d\n" +
@@ -836,7 +1192,22 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"address_id\x18\x01 \x01(\x03R\taddressId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\"5\n" +
 	"\x19SetDefaultAddressResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xed\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x19PurgeUserAddressesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"6\n" +
+	"\x1aPurgeUserAddressesResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"m\n" +
+	"\n" +
+	"RegionInfo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x12!\n" +
+	"\fhas_children\x18\x04 \x01(\bR\vhasChildren\"5\n" +
+	"\x12ListRegionsRequest\x12\x1f\n" +
+	"\vparent_code\x18\x01 \x01(\tR\n" +
+	"parentCode\"D\n" +
+	"\x13ListRegionsResponse\x12-\n" +
+	"\aregions\x18\x01 \x03(\v2\x13.address.RegionInfoR\aregions2\x96\x05\n" +
 	"\x0eAddressService\x12N\n" +
 	"\rCreateAddress\x12\x1d.address.CreateAddressRequest\x1a\x1e.address.CreateAddressResponse\x12H\n" +
 	"\vListAddress\x12\x1b.address.ListAddressRequest\x1a\x1c.address.ListAddressResponse\x12E\n" +
@@ -844,7 +1215,9 @@ const file_proto_address_address_proto_rawDesc = "" +
 	"GetAddress\x12\x1a.address.GetAddressRequest\x1a\x1b.address.GetAddressResponse\x12N\n" +
 	"\rUpdateAddress\x12\x1d.address.UpdateAddressRequest\x1a\x1e.address.UpdateAddressResponse\x12N\n" +
 	"\rDeleteAddress\x12\x1d.address.DeleteAddressRequest\x1a\x1e.address.DeleteAddressResponse\x12Z\n" +
-	"\x11SetDefaultAddress\x12!.address.SetDefaultAddressRequest\x1a\".address.SetDefaultAddressResponseB\x1cZ\x1ago-ecommerce/proto/addressb\x06proto3"
+	"\x11SetDefaultAddress\x12!.address.SetDefaultAddressRequest\x1a\".address.SetDefaultAddressResponse\x12]\n" +
+	"\x12PurgeUserAddresses\x12\".address.PurgeUserAddressesRequest\x1a#.address.PurgeUserAddressesResponse\x12H\n" +
+	"\vListRegions\x12\x1b.address.ListRegionsRequest\x1a\x1c.address.ListRegionsResponseB\x1cZ\x1ago-ecommerce/proto/addressb\x06proto3"
 
 var (
 	file_proto_address_address_proto_rawDescOnce sync.Once
@@ -858,42 +1231,52 @@ func file_proto_address_address_proto_rawDescGZIP() []byte {
 	return file_proto_address_address_proto_rawDescData
 }
 
-var file_proto_address_address_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_address_address_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_address_address_proto_goTypes = []any{
-	(*AddressInfo)(nil),               // 0: address.AddressInfo
-	(*CreateAddressRequest)(nil),      // 1: address.CreateAddressRequest
-	(*CreateAddressResponse)(nil),     // 2: address.CreateAddressResponse
-	(*ListAddressRequest)(nil),        // 3: address.ListAddressRequest
-	(*ListAddressResponse)(nil),       // 4: address.ListAddressResponse
-	(*GetAddressRequest)(nil),         // 5: address.GetAddressRequest
-	(*GetAddressResponse)(nil),        // 6: address.GetAddressResponse
-	(*UpdateAddressRequest)(nil),      // 7: address.UpdateAddressRequest
-	(*UpdateAddressResponse)(nil),     // 8: address.UpdateAddressResponse
-	(*DeleteAddressRequest)(nil),      // 9: address.DeleteAddressRequest
-	(*DeleteAddressResponse)(nil),     // 10: address.DeleteAddressResponse
-	(*SetDefaultAddressRequest)(nil),  // 11: address.SetDefaultAddressRequest
-	(*SetDefaultAddressResponse)(nil), // 12: address.SetDefaultAddressResponse
+	(*AddressInfo)(nil),                // 0: address.AddressInfo
+	(*CreateAddressRequest)(nil),       // 1: address.CreateAddressRequest
+	(*CreateAddressResponse)(nil),      // 2: address.CreateAddressResponse
+	(*ListAddressRequest)(nil),         // 3: address.ListAddressRequest
+	(*ListAddressResponse)(nil),        // 4: address.ListAddressResponse
+	(*GetAddressRequest)(nil),          // 5: address.GetAddressRequest
+	(*GetAddressResponse)(nil),         // 6: address.GetAddressResponse
+	(*UpdateAddressRequest)(nil),       // 7: address.UpdateAddressRequest
+	(*UpdateAddressResponse)(nil),      // 8: address.UpdateAddressResponse
+	(*DeleteAddressRequest)(nil),       // 9: address.DeleteAddressRequest
+	(*DeleteAddressResponse)(nil),      // 10: address.DeleteAddressResponse
+	(*SetDefaultAddressRequest)(nil),   // 11: address.SetDefaultAddressRequest
+	(*SetDefaultAddressResponse)(nil),  // 12: address.SetDefaultAddressResponse
+	(*PurgeUserAddressesRequest)(nil),  // 13: address.PurgeUserAddressesRequest
+	(*PurgeUserAddressesResponse)(nil), // 14: address.PurgeUserAddressesResponse
+	(*RegionInfo)(nil),                 // 15: address.RegionInfo
+	(*ListRegionsRequest)(nil),         // 16: address.ListRegionsRequest
+	(*ListRegionsResponse)(nil),        // 17: address.ListRegionsResponse
 }
 var file_proto_address_address_proto_depIdxs = []int32{
 	0,  // 0: address.ListAddressResponse.addresses:type_name -> address.AddressInfo
 	0,  // 1: address.GetAddressResponse.address:type_name -> address.AddressInfo
-	1,  // 2: address.AddressService.CreateAddress:input_type -> address.CreateAddressRequest
-	3,  // 3: address.AddressService.ListAddress:input_type -> address.ListAddressRequest
-	5,  // 4: address.AddressService.GetAddress:input_type -> address.GetAddressRequest
-	7,  // 5: address.AddressService.UpdateAddress:input_type -> address.UpdateAddressRequest
-	9,  // 6: address.AddressService.DeleteAddress:input_type -> address.DeleteAddressRequest
-	11, // 7: address.AddressService.SetDefaultAddress:input_type -> address.SetDefaultAddressRequest
-	2,  // 8: address.AddressService.CreateAddress:output_type -> address.CreateAddressResponse
-	4,  // 9: address.AddressService.ListAddress:output_type -> address.ListAddressResponse
-	6,  // 10: address.AddressService.GetAddress:output_type -> address.GetAddressResponse
-	8,  // 11: address.AddressService.UpdateAddress:output_type -> address.UpdateAddressResponse
-	10, // 12: address.AddressService.DeleteAddress:output_type -> address.DeleteAddressResponse
-	12, // 13: address.AddressService.SetDefaultAddress:output_type -> address.SetDefaultAddressResponse
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	15, // 2: address.ListRegionsResponse.regions:type_name -> address.RegionInfo
+	1,  // 3: address.AddressService.CreateAddress:input_type -> address.CreateAddressRequest
+	3,  // 4: address.AddressService.ListAddress:input_type -> address.ListAddressRequest
+	5,  // 5: address.AddressService.GetAddress:input_type -> address.GetAddressRequest
+	7,  // 6: address.AddressService.UpdateAddress:input_type -> address.UpdateAddressRequest
+	9,  // 7: address.AddressService.DeleteAddress:input_type -> address.DeleteAddressRequest
+	11, // 8: address.AddressService.SetDefaultAddress:input_type -> address.SetDefaultAddressRequest
+	13, // 9: address.AddressService.PurgeUserAddresses:input_type -> address.PurgeUserAddressesRequest
+	16, // 10: address.AddressService.ListRegions:input_type -> address.ListRegionsRequest
+	2,  // 11: address.AddressService.CreateAddress:output_type -> address.CreateAddressResponse
+	4,  // 12: address.AddressService.ListAddress:output_type -> address.ListAddressResponse
+	6,  // 13: address.AddressService.GetAddress:output_type -> address.GetAddressResponse
+	8,  // 14: address.AddressService.UpdateAddress:output_type -> address.UpdateAddressResponse
+	10, // 15: address.AddressService.DeleteAddress:output_type -> address.DeleteAddressResponse
+	12, // 16: address.AddressService.SetDefaultAddress:output_type -> address.SetDefaultAddressResponse
+	14, // 17: address.AddressService.PurgeUserAddresses:output_type -> address.PurgeUserAddressesResponse
+	17, // 18: address.AddressService.ListRegions:output_type -> address.ListRegionsResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_address_address_proto_init() }
@@ -907,7 +1290,7 @@ func file_proto_address_address_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_address_address_proto_rawDesc), len(file_proto_address_address_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
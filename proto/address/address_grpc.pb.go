@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AddressService_CreateAddress_FullMethodName     = "/address.AddressService/CreateAddress"
-	AddressService_ListAddress_FullMethodName       = "/address.AddressService/ListAddress"
-	AddressService_GetAddress_FullMethodName        = "/address.AddressService/GetAddress"
-	AddressService_UpdateAddress_FullMethodName     = "/address.AddressService/UpdateAddress"
-	AddressService_DeleteAddress_FullMethodName     = "/address.AddressService/DeleteAddress"
-	AddressService_SetDefaultAddress_FullMethodName = "/address.AddressService/SetDefaultAddress"
+	AddressService_CreateAddress_FullMethodName      = "/address.AddressService/CreateAddress"
+	AddressService_ListAddress_FullMethodName        = "/address.AddressService/ListAddress"
+	AddressService_GetAddress_FullMethodName         = "/address.AddressService/GetAddress"
+	AddressService_UpdateAddress_FullMethodName      = "/address.AddressService/UpdateAddress"
+	AddressService_DeleteAddress_FullMethodName      = "/address.AddressService/DeleteAddress"
+	AddressService_SetDefaultAddress_FullMethodName  = "/address.AddressService/SetDefaultAddress"
+	AddressService_PurgeUserAddresses_FullMethodName = "/address.AddressService/PurgeUserAddresses"
+	AddressService_ListRegions_FullMethodName        = "/address.AddressService/ListRegions"
 )
 
 // AddressServiceClient is the client API for AddressService service.
@@ -37,6 +39,10 @@ type AddressServiceClient interface {
 	UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*UpdateAddressResponse, error)
 	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error)
 	SetDefaultAddress(ctx context.Context, in *SetDefaultAddressRequest, opts ...grpc.CallOption) (*SetDefaultAddressResponse, error)
+	// 注销账号时清除用户全部地址
+	PurgeUserAddresses(ctx context.Context, in *PurgeUserAddressesRequest, opts ...grpc.CallOption) (*PurgeUserAddressesResponse, error)
+	// 行政区划级联查询 (省 -> 市 -> 区县)
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error)
 }
 
 type addressServiceClient struct {
@@ -107,6 +113,26 @@ func (c *addressServiceClient) SetDefaultAddress(ctx context.Context, in *SetDef
 	return out, nil
 }
 
+func (c *addressServiceClient) PurgeUserAddresses(ctx context.Context, in *PurgeUserAddressesRequest, opts ...grpc.CallOption) (*PurgeUserAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeUserAddressesResponse)
+	err := c.cc.Invoke(ctx, AddressService_PurgeUserAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionsResponse)
+	err := c.cc.Invoke(ctx, AddressService_ListRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AddressServiceServer is the server API for AddressService service.
 // All implementations must embed UnimplementedAddressServiceServer
 // for forward compatibility.
@@ -117,6 +143,10 @@ type AddressServiceServer interface {
 	UpdateAddress(context.Context, *UpdateAddressRequest) (*UpdateAddressResponse, error)
 	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error)
 	SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*SetDefaultAddressResponse, error)
+	// 注销账号时清除用户全部地址
+	PurgeUserAddresses(context.Context, *PurgeUserAddressesRequest) (*PurgeUserAddressesResponse, error)
+	// 行政区划级联查询 (省 -> 市 -> 区县)
+	ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error)
 	mustEmbedUnimplementedAddressServiceServer()
 }
 
@@ -145,6 +175,12 @@ func (UnimplementedAddressServiceServer) DeleteAddress(context.Context, *DeleteA
 func (UnimplementedAddressServiceServer) SetDefaultAddress(context.Context, *SetDefaultAddressRequest) (*SetDefaultAddressResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetDefaultAddress not implemented")
 }
+func (UnimplementedAddressServiceServer) PurgeUserAddresses(context.Context, *PurgeUserAddressesRequest) (*PurgeUserAddressesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeUserAddresses not implemented")
+}
+func (UnimplementedAddressServiceServer) ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRegions not implemented")
+}
 func (UnimplementedAddressServiceServer) mustEmbedUnimplementedAddressServiceServer() {}
 func (UnimplementedAddressServiceServer) testEmbeddedByValue()                        {}
 
@@ -274,6 +310,42 @@ func _AddressService_SetDefaultAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AddressService_PurgeUserAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).PurgeUserAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_PurgeUserAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).PurgeUserAddresses(ctx, req.(*PurgeUserAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_ListRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AddressService_ServiceDesc is the grpc.ServiceDesc for AddressService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +377,14 @@ var AddressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaultAddress",
 			Handler:    _AddressService_SetDefaultAddress_Handler,
 		},
+		{
+			MethodName: "PurgeUserAddresses",
+			Handler:    _AddressService_PurgeUserAddresses_Handler,
+		},
+		{
+			MethodName: "ListRegions",
+			Handler:    _AddressService_ListRegions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/address/address.proto",
@@ -60,13 +60,13 @@ func (*StatsRequest) Descriptor() ([]byte, []int) {
 
 type StatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalSales    float32                `protobuf:"fixed32,1,opt,name=total_sales,json=totalSales,proto3" json:"total_sales,omitempty"`
+	TotalSales    int64                  `protobuf:"varint,1,opt,name=total_sales,json=totalSales,proto3" json:"total_sales,omitempty"`
 	OrderCount    int32                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
 	UserCount     int32                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
 	ProductCount  int32                  `protobuf:"varint,4,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"`
 	CategoryStats []*CategoryStat        `protobuf:"bytes,5,rep,name=category_stats,json=categoryStats,proto3" json:"category_stats,omitempty"`
 	SalesTrend    []*TrendStat           `protobuf:"bytes,6,rep,name=sales_trend,json=salesTrend,proto3" json:"sales_trend,omitempty"`
-	ActualSales   float32                `protobuf:"fixed32,7,opt,name=actual_sales,json=actualSales,proto3" json:"actual_sales,omitempty"`
+	ActualSales   int64                  `protobuf:"varint,7,opt,name=actual_sales,json=actualSales,proto3" json:"actual_sales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -101,7 +101,7 @@ func (*StatsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *StatsResponse) GetTotalSales() float32 {
+func (x *StatsResponse) GetTotalSales() int64 {
 	if x != nil {
 		return x.TotalSales
 	}
@@ -143,7 +143,7 @@ func (x *StatsResponse) GetSalesTrend() []*TrendStat {
 	return nil
 }
 
-func (x *StatsResponse) GetActualSales() float32 {
+func (x *StatsResponse) GetActualSales() int64 {
 	if x != nil {
 		return x.ActualSales
 	}
@@ -534,7 +534,8 @@ type ListAllProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // 🔥 新增：支持按分类过滤查询
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 按分类过滤 (包含子分类)，0 表示全部
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                            // 按状态过滤，为空表示除已删除外的全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -583,9 +584,16 @@ func (x *ListAllProductsRequest) GetPageSize() int32 {
 	return 0
 }
 
-func (x *ListAllProductsRequest) GetCategory() string {
+func (x *ListAllProductsRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.Category
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListAllProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
@@ -594,10 +602,14 @@ type AdminProductInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
 	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"` // 分类名称
+	CategoryId    int64                  `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                               // draft / on_sale / off_shelf
+	OnShelfAt     int64                  `protobuf:"varint,9,opt,name=on_shelf_at,json=onShelfAt,proto3" json:"on_shelf_at,omitempty"`     // 定时上架 (Unix 秒)，0 表示未设置
+	OffShelfAt    int64                  `protobuf:"varint,10,opt,name=off_shelf_at,json=offShelfAt,proto3" json:"off_shelf_at,omitempty"` // 定时下架 (Unix 秒)，0 表示未设置
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -646,7 +658,7 @@ func (x *AdminProductInfo) GetName() string {
 	return ""
 }
 
-func (x *AdminProductInfo) GetPrice() float32 {
+func (x *AdminProductInfo) GetPrice() int64 {
 	if x != nil {
 		return x.Price
 	}
@@ -674,6 +686,34 @@ func (x *AdminProductInfo) GetCategory() string {
 	return ""
 }
 
+func (x *AdminProductInfo) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AdminProductInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminProductInfo) GetOnShelfAt() int64 {
+	if x != nil {
+		return x.OnShelfAt
+	}
+	return 0
+}
+
+func (x *AdminProductInfo) GetOffShelfAt() int64 {
+	if x != nil {
+		return x.OffShelfAt
+	}
+	return 0
+}
+
 type ListAllProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*AdminProductInfo    `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
@@ -726,12 +766,16 @@ func (x *ListAllProductsResponse) GetTotal() int32 {
 	return 0
 }
 
+// UpdateProductRequest name 为空、price 为 0 表示不修改，其余字段不传表示不修改
 type UpdateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         *int32                 `protobuf:"varint,4,opt,name=stock,proto3,oneof" json:"stock,omitempty"` // 仅单规格商品可直接设置，多规格请用 SetSkuStock
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Picture       *string                `protobuf:"bytes,6,opt,name=picture,proto3,oneof" json:"picture,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -780,7 +824,7 @@ func (x *UpdateProductRequest) GetName() string {
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPrice() float32 {
+func (x *UpdateProductRequest) GetPrice() int64 {
 	if x != nil {
 		return x.Price
 	}
@@ -788,8 +832,29 @@ func (x *UpdateProductRequest) GetPrice() float32 {
 }
 
 func (x *UpdateProductRequest) GetStock() int32 {
-	if x != nil {
-		return x.Stock
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPicture() string {
+	if x != nil && x.Picture != nil {
+		return *x.Picture
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
 	return 0
 }
@@ -838,27 +903,34 @@ func (x *UpdateProductResponse) GetSuccess() bool {
 	return false
 }
 
-type DeleteProductRequest struct {
+type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Picture       string                 `protobuf:"bytes,3,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Skus          []*AdminSkuInfo        `protobuf:"bytes,6,rep,name=skus,proto3" json:"skus,omitempty"`     // 至少一个规格
+	Specs         []*AdminSpecDimension  `protobuf:"bytes,7,rep,name=specs,proto3" json:"specs,omitempty"`   // 规格维度，此时 SKU 用 spec_values 按值选择
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // draft / on_sale，为空表示草稿
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *CreateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*CreateProductRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -870,39 +942,88 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteProductRequest) GetId() int64 {
+func (x *CreateProductRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
 	}
 	return 0
 }
 
-type DeleteProductResponse struct {
+func (x *CreateProductRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSkus() []*AdminSkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSpecs() []*AdminSpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
 	mi := &file_proto_admin_admin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *CreateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*CreateProductResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,40 +1035,48 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteProductResponse) GetSuccess() bool {
+func (x *CreateProductResponse) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type BatchPriceRequest struct {
+type AdminSkuInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Ratio         float32                `protobuf:"fixed32,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"` // SaveSku 修改时忽略，请用 SetSkuStock
+	Picture       string                 `protobuf:"bytes,6,opt,name=picture,proto3" json:"picture,omitempty"`
+	Weight        int32                  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`                                          // 克
+	SpecValueIds  []int64                `protobuf:"varint,8,rep,packed,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"` // 按规格维度顺序；修改时为空表示不修改
+	SpecValues    []string               `protobuf:"bytes,9,rep,name=spec_values,json=specValues,proto3" json:"spec_values,omitempty"`                 // 仅新建商品时使用：按维度顺序给出规格值文本
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                          // 只读，修改请用 SetSkuStatus
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchPriceRequest) Reset() {
-	*x = BatchPriceRequest{}
+func (x *AdminSkuInfo) Reset() {
+	*x = AdminSkuInfo{}
 	mi := &file_proto_admin_admin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchPriceRequest) String() string {
+func (x *AdminSkuInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchPriceRequest) ProtoMessage() {}
+func (*AdminSkuInfo) ProtoMessage() {}
 
-func (x *BatchPriceRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminSkuInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,46 +1088,105 @@ func (x *BatchPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchPriceRequest.ProtoReflect.Descriptor instead.
-func (*BatchPriceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminSkuInfo.ProtoReflect.Descriptor instead.
+func (*AdminSkuInfo) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BatchPriceRequest) GetCategory() string {
+func (x *AdminSkuInfo) GetId() int64 {
 	if x != nil {
-		return x.Category
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSkuInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AdminSkuInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BatchPriceRequest) GetRatio() float32 {
+func (x *AdminSkuInfo) GetPrice() int64 {
 	if x != nil {
-		return x.Ratio
+		return x.Price
 	}
 	return 0
 }
 
-type BatchPriceResponse struct {
+func (x *AdminSkuInfo) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *AdminSkuInfo) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *AdminSkuInfo) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AdminSkuInfo) GetSpecValueIds() []int64 {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return nil
+}
+
+func (x *AdminSkuInfo) GetSpecValues() []string {
+	if x != nil {
+		return x.SpecValues
+	}
+	return nil
+}
+
+func (x *AdminSkuInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 规格维度，如：重量 [3斤, 5斤]
+type AdminSpecDimension struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 新增时为 0
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []*AdminSpecValue      `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchPriceResponse) Reset() {
-	*x = BatchPriceResponse{}
+func (x *AdminSpecDimension) Reset() {
+	*x = AdminSpecDimension{}
 	mi := &file_proto_admin_admin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchPriceResponse) String() string {
+func (x *AdminSpecDimension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchPriceResponse) ProtoMessage() {}
+func (*AdminSpecDimension) ProtoMessage() {}
 
-func (x *BatchPriceResponse) ProtoReflect() protoreflect.Message {
+func (x *AdminSpecDimension) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,39 +1198,54 @@ func (x *BatchPriceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchPriceResponse.ProtoReflect.Descriptor instead.
-func (*BatchPriceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminSpecDimension.ProtoReflect.Descriptor instead.
+func (*AdminSpecDimension) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *BatchPriceResponse) GetSuccess() bool {
+func (x *AdminSpecDimension) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type ShipOrderRequest struct {
+func (x *AdminSpecDimension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminSpecDimension) GetValues() []*AdminSpecValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AdminSpecValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 新增时为 0
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipOrderRequest) Reset() {
-	*x = ShipOrderRequest{}
+func (x *AdminSpecValue) Reset() {
+	*x = AdminSpecValue{}
 	mi := &file_proto_admin_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipOrderRequest) String() string {
+func (x *AdminSpecValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipOrderRequest) ProtoMessage() {}
+func (*AdminSpecValue) ProtoMessage() {}
 
-func (x *ShipOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminSpecValue) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,39 +1257,46 @@ func (x *ShipOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipOrderRequest.ProtoReflect.Descriptor instead.
-func (*ShipOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminSpecValue.ProtoReflect.Descriptor instead.
+func (*AdminSpecValue) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ShipOrderRequest) GetOrderNo() string {
+func (x *AdminSpecValue) GetId() int64 {
 	if x != nil {
-		return x.OrderNo
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSpecValue) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-type ShipOrderResponse struct {
+type ListSkusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipOrderResponse) Reset() {
-	*x = ShipOrderResponse{}
+func (x *ListSkusRequest) Reset() {
+	*x = ListSkusRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipOrderResponse) String() string {
+func (x *ListSkusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipOrderResponse) ProtoMessage() {}
+func (*ListSkusRequest) ProtoMessage() {}
 
-func (x *ShipOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSkusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1098,40 +1308,40 @@ func (x *ShipOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipOrderResponse.ProtoReflect.Descriptor instead.
-func (*ShipOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSkusRequest.ProtoReflect.Descriptor instead.
+func (*ListSkusRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ShipOrderResponse) GetSuccess() bool {
+func (x *ListSkusRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ProductId
 	}
-	return false
+	return 0
 }
 
-type CategoryStat struct {
+type ListSkusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Skus          []*AdminSkuInfo        `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	Specs         []*AdminSpecDimension  `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryStat) Reset() {
-	*x = CategoryStat{}
+func (x *ListSkusResponse) Reset() {
+	*x = ListSkusResponse{}
 	mi := &file_proto_admin_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryStat) String() string {
+func (x *ListSkusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryStat) ProtoMessage() {}
+func (*ListSkusResponse) ProtoMessage() {}
 
-func (x *CategoryStat) ProtoReflect() protoreflect.Message {
+func (x *ListSkusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1143,47 +1353,48 @@ func (x *CategoryStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryStat.ProtoReflect.Descriptor instead.
-func (*CategoryStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSkusResponse.ProtoReflect.Descriptor instead.
+func (*ListSkusResponse) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CategoryStat) GetName() string {
+func (x *ListSkusResponse) GetSkus() []*AdminSkuInfo {
 	if x != nil {
-		return x.Name
+		return x.Skus
 	}
-	return ""
+	return nil
 }
 
-func (x *CategoryStat) GetValue() int32 {
+func (x *ListSkusResponse) GetSpecs() []*AdminSpecDimension {
 	if x != nil {
-		return x.Value
+		return x.Specs
 	}
-	return 0
+	return nil
 }
 
-type TrendStat struct {
+// SetProductSpecsRequest 未出现的维度 / 值将被删除；已有规格组合的 SKU 时不能增删维度或删除被使用的值
+type SetProductSpecsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Specs         []*AdminSpecDimension  `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrendStat) Reset() {
-	*x = TrendStat{}
+func (x *SetProductSpecsRequest) Reset() {
+	*x = SetProductSpecsRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrendStat) String() string {
+func (x *SetProductSpecsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrendStat) ProtoMessage() {}
+func (*SetProductSpecsRequest) ProtoMessage() {}
 
-func (x *TrendStat) ProtoReflect() protoreflect.Message {
+func (x *SetProductSpecsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1195,33 +1406,2045 @@ func (x *TrendStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrendStat.ProtoReflect.Descriptor instead.
-func (*TrendStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProductSpecsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductSpecsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TrendStat) GetDate() string {
+func (x *SetProductSpecsRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Date
+		return x.ProductId
 	}
-	return ""
+	return 0
 }
 
-func (x *TrendStat) GetAmount() float32 {
+func (x *SetProductSpecsRequest) GetSpecs() []*AdminSpecDimension {
 	if x != nil {
-		return x.Amount
+		return x.Specs
 	}
-	return 0
+	return nil
 }
 
-var File_proto_admin_admin_proto protoreflect.FileDescriptor
-
-const file_proto_admin_admin_proto_rawDesc = "" +
-	"\n" +
+type SetProductSpecsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Specs         []*AdminSpecDimension  `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductSpecsResponse) Reset() {
+	*x = SetProductSpecsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductSpecsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductSpecsResponse) ProtoMessage() {}
+
+func (x *SetProductSpecsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductSpecsResponse.ProtoReflect.Descriptor instead.
+func (*SetProductSpecsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetProductSpecsResponse) GetSpecs() []*AdminSpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+type SaveSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *AdminSkuInfo          `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSkuRequest) Reset() {
+	*x = SaveSkuRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSkuRequest) ProtoMessage() {}
+
+func (x *SaveSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSkuRequest.ProtoReflect.Descriptor instead.
+func (*SaveSkuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SaveSkuRequest) GetSku() *AdminSkuInfo {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type SaveSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSkuResponse) Reset() {
+	*x = SaveSkuResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSkuResponse) ProtoMessage() {}
+
+func (x *SaveSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSkuResponse.ProtoReflect.Descriptor instead.
+func (*SaveSkuResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SaveSkuResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SetSkuStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Stock         int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStockRequest) Reset() {
+	*x = SetSkuStockRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStockRequest) ProtoMessage() {}
+
+func (x *SetSkuStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStockRequest.ProtoReflect.Descriptor instead.
+func (*SetSkuStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetSkuStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetSkuStockRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type SetSkuStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStockResponse) Reset() {
+	*x = SetSkuStockResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStockResponse) ProtoMessage() {}
+
+func (x *SetSkuStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStockResponse.ProtoReflect.Descriptor instead.
+func (*SetSkuStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetSkuStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetProductStatusRequest 定时时间每次整体覆盖，0 表示取消
+type SetProductStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                              // draft / on_sale / off_shelf，为空表示不修改
+	OnShelfAt     int64                  `protobuf:"varint,3,opt,name=on_shelf_at,json=onShelfAt,proto3" json:"on_shelf_at,omitempty"`    // 定时上架 (Unix 秒)
+	OffShelfAt    int64                  `protobuf:"varint,4,opt,name=off_shelf_at,json=offShelfAt,proto3" json:"off_shelf_at,omitempty"` // 定时下架 (Unix 秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductStatusRequest) Reset() {
+	*x = SetProductStatusRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductStatusRequest) ProtoMessage() {}
+
+func (x *SetProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetProductStatusRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetProductStatusRequest) GetOnShelfAt() int64 {
+	if x != nil {
+		return x.OnShelfAt
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetOffShelfAt() int64 {
+	if x != nil {
+		return x.OffShelfAt
+	}
+	return 0
+}
+
+type SetProductStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductStatusResponse) Reset() {
+	*x = SetProductStatusResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductStatusResponse) ProtoMessage() {}
+
+func (x *SetProductStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetProductStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetProductStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetSkuStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // on_sale / off_shelf
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStatusRequest) Reset() {
+	*x = SetSkuStatusRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStatusRequest) ProtoMessage() {}
+
+func (x *SetSkuStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetSkuStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetSkuStatusRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetSkuStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetSkuStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStatusResponse) Reset() {
+	*x = SetSkuStatusResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStatusResponse) ProtoMessage() {}
+
+func (x *SetSkuStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetSkuStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetSkuStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BatchPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 包含子分类
+	RatioBp       int32                  `protobuf:"varint,2,opt,name=ratio_bp,json=ratioBp,proto3" json:"ratio_bp,omitempty"`          // 调价比例 (万分比)：9000 表示打九折，11000 表示上调 10%
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPriceRequest) Reset() {
+	*x = BatchPriceRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPriceRequest) ProtoMessage() {}
+
+func (x *BatchPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPriceRequest.ProtoReflect.Descriptor instead.
+func (*BatchPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchPriceRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BatchPriceRequest) GetRatioBp() int32 {
+	if x != nil {
+		return x.RatioBp
+	}
+	return 0
+}
+
+type BatchPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchPriceResponse) Reset() {
+	*x = BatchPriceResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPriceResponse) ProtoMessage() {}
+
+func (x *BatchPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPriceResponse.ProtoReflect.Descriptor instead.
+func (*BatchPriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchPriceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ShipOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipOrderRequest) Reset() {
+	*x = ShipOrderRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipOrderRequest) ProtoMessage() {}
+
+func (x *ShipOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipOrderRequest.ProtoReflect.Descriptor instead.
+func (*ShipOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShipOrderRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+type ShipOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipOrderResponse) Reset() {
+	*x = ShipOrderResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipOrderResponse) ProtoMessage() {}
+
+func (x *ShipOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipOrderResponse.ProtoReflect.Descriptor instead.
+func (*ShipOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShipOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CategoryStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryStat) Reset() {
+	*x = CategoryStat{}
+	mi := &file_proto_admin_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStat) ProtoMessage() {}
+
+func (x *CategoryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStat.ProtoReflect.Descriptor instead.
+func (*CategoryStat) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CategoryStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryStat) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type TrendStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendStat) Reset() {
+	*x = TrendStat{}
+	mi := &file_proto_admin_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendStat) ProtoMessage() {}
+
+func (x *TrendStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendStat.ProtoReflect.Descriptor instead.
+func (*TrendStat) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TrendStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TrendStat) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 运费规则：regions 为空表示全国默认规则
+type ShippingRuleInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Regions        []string               `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`          // 适用省份编码
+	Deliverable    bool                   `protobuf:"varint,4,opt,name=deliverable,proto3" json:"deliverable,omitempty"` // false 表示该区域不配送
+	Mode           string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`                // count 按件 / weight 按重量 (克)
+	FirstUnit      int32                  `protobuf:"varint,6,opt,name=first_unit,json=firstUnit,proto3" json:"first_unit,omitempty"`
+	FirstFee       int64                  `protobuf:"varint,7,opt,name=first_fee,json=firstFee,proto3" json:"first_fee,omitempty"`
+	AdditionalUnit int32                  `protobuf:"varint,8,opt,name=additional_unit,json=additionalUnit,proto3" json:"additional_unit,omitempty"`
+	AdditionalFee  int64                  `protobuf:"varint,9,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee,omitempty"`
+	FreeThreshold  int64                  `protobuf:"varint,10,opt,name=free_threshold,json=freeThreshold,proto3" json:"free_threshold,omitempty"` // 满额包邮，0 表示不包邮
+	Priority       int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                                // 多条规则命中同一省份时取优先级高的
+	Enabled        bool                   `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShippingRuleInfo) Reset() {
+	*x = ShippingRuleInfo{}
+	mi := &file_proto_admin_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingRuleInfo) ProtoMessage() {}
+
+func (x *ShippingRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingRuleInfo.ProtoReflect.Descriptor instead.
+func (*ShippingRuleInfo) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ShippingRuleInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShippingRuleInfo) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ShippingRuleInfo) GetDeliverable() bool {
+	if x != nil {
+		return x.Deliverable
+	}
+	return false
+}
+
+func (x *ShippingRuleInfo) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ShippingRuleInfo) GetFirstUnit() int32 {
+	if x != nil {
+		return x.FirstUnit
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetFirstFee() int64 {
+	if x != nil {
+		return x.FirstFee
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetAdditionalUnit() int32 {
+	if x != nil {
+		return x.AdditionalUnit
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetAdditionalFee() int64 {
+	if x != nil {
+		return x.AdditionalFee
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetFreeThreshold() int64 {
+	if x != nil {
+		return x.FreeThreshold
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListShippingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingRulesRequest) Reset() {
+	*x = ListShippingRulesRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingRulesRequest) ProtoMessage() {}
+
+func (x *ListShippingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListShippingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{40}
+}
+
+type ListShippingRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ShippingRuleInfo    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShippingRulesResponse) Reset() {
+	*x = ListShippingRulesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShippingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShippingRulesResponse) ProtoMessage() {}
+
+func (x *ListShippingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShippingRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListShippingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListShippingRulesResponse) GetRules() []*ShippingRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SaveShippingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ShippingRuleInfo      `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveShippingRuleRequest) Reset() {
+	*x = SaveShippingRuleRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveShippingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShippingRuleRequest) ProtoMessage() {}
+
+func (x *SaveShippingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShippingRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveShippingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SaveShippingRuleRequest) GetRule() *ShippingRuleInfo {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SaveShippingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveShippingRuleResponse) Reset() {
+	*x = SaveShippingRuleResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveShippingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShippingRuleResponse) ProtoMessage() {}
+
+func (x *SaveShippingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShippingRuleResponse.ProtoReflect.Descriptor instead.
+func (*SaveShippingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SaveShippingRuleResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteShippingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingRuleRequest) Reset() {
+	*x = DeleteShippingRuleRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingRuleRequest) ProtoMessage() {}
+
+func (x *DeleteShippingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteShippingRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteShippingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingRuleResponse) Reset() {
+	*x = DeleteShippingRuleResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingRuleResponse) ProtoMessage() {}
+
+func (x *DeleteShippingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteShippingRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 分类树节点
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	ProductCount  int64                  `protobuf:"varint,5,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"` // 直接挂在该分类下的商品数
+	Children      []*CategoryNode        `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_proto_admin_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CategoryNode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetProductCount() int64 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{47}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryNode        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type SaveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 表示一级分类
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCategoryRequest) Reset() {
+	*x = SaveCategoryRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCategoryRequest) ProtoMessage() {}
+
+func (x *SaveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SaveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SaveCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SaveCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *SaveCategoryRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type SaveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCategoryResponse) Reset() {
+	*x = SaveCategoryResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCategoryResponse) ProtoMessage() {}
+
+func (x *SaveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SaveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SaveCategoryResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReindexSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexSearchRequest) Reset() {
+	*x = ReindexSearchRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexSearchRequest) ProtoMessage() {}
+
+func (x *ReindexSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexSearchRequest.ProtoReflect.Descriptor instead.
+func (*ReindexSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{53}
+}
+
+type ReindexSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       bool                   `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"` // 已开始后台重建，完成后自动切换，结果见商品服务日志
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexSearchResponse) Reset() {
+	*x = ReindexSearchResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexSearchResponse) ProtoMessage() {}
+
+func (x *ReindexSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexSearchResponse.ProtoReflect.Descriptor instead.
+func (*ReindexSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReindexSearchResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+// 字段含义同 promotion.CouponInfo
+type CouponInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // fixed: 满减 / percent: 折扣
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Percent        int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`                            // 85 表示 85 折
+	MaxDiscount    int64                  `protobuf:"varint,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 0 表示不限
+	Threshold      int64                  `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 0 表示无门槛
+	Scope          string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`                                 // all / category / sku
+	ScopeIds       []int64                `protobuf:"varint,9,rep,packed,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	TotalQuantity  int32                  `protobuf:"varint,10,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // 0 表示不限
+	IssuedQuantity int32                  `protobuf:"varint,11,opt,name=issued_quantity,json=issuedQuantity,proto3" json:"issued_quantity,omitempty"`
+	ClaimLimit     int32                  `protobuf:"varint,12,opt,name=claim_limit,json=claimLimit,proto3" json:"claim_limit,omitempty"`
+	UseLimit       int32                  `protobuf:"varint,13,opt,name=use_limit,json=useLimit,proto3" json:"use_limit,omitempty"`
+	StartAt        int64                  `protobuf:"varint,14,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt          int64                  `protobuf:"varint,15,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	ValidDays      int32                  `protobuf:"varint,16,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`
+	Enabled        bool                   `protobuf:"varint,17,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponInfo) Reset() {
+	*x = CouponInfo{}
+	mi := &file_proto_admin_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponInfo) ProtoMessage() {}
+
+func (x *CouponInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponInfo.ProtoReflect.Descriptor instead.
+func (*CouponInfo) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CouponInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetScopeIds() []int64 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CouponInfo) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetIssuedQuantity() int32 {
+	if x != nil {
+		return x.IssuedQuantity
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetClaimLimit() int32 {
+	if x != nil {
+		return x.ClaimLimit
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetUseLimit() int32 {
+	if x != nil {
+		return x.UseLimit
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCouponsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCouponsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*CouponInfo          `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsResponse) ProtoMessage() {}
+
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCouponsResponse) GetCoupons() []*CouponInfo {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *ListCouponsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SaveCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *CouponInfo            `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCouponRequest) Reset() {
+	*x = SaveCouponRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCouponRequest) ProtoMessage() {}
+
+func (x *SaveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCouponRequest.ProtoReflect.Descriptor instead.
+func (*SaveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SaveCouponRequest) GetCoupon() *CouponInfo {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type SaveCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCouponResponse) Reset() {
+	*x = SaveCouponResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCouponResponse) ProtoMessage() {}
+
+func (x *SaveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCouponResponse.ProtoReflect.Descriptor instead.
+func (*SaveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SaveCouponResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_proto_admin_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_admin_proto_rawDesc = "" +
+	"\n" +
 	"\x17proto/admin/admin.proto\x12\x05admin\"\x0e\n" +
 	"\fStatsRequest\"\xa7\x02\n" +
 	"\rStatsResponse\x12\x1f\n" +
-	"\vtotal_sales\x18\x01 \x01(\x02R\n" +
+	"\vtotal_sales\x18\x01 \x01(\x03R\n" +
 	"totalSales\x12\x1f\n" +
 	"\vorder_count\x18\x02 \x01(\x05R\n" +
 	"orderCount\x12\x1d\n" +
@@ -1231,7 +3454,7 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\x0ecategory_stats\x18\x05 \x03(\v2\x13.admin.CategoryStatR\rcategoryStats\x121\n" +
 	"\vsales_trend\x18\x06 \x03(\v2\x10.admin.TrendStatR\n" +
 	"salesTrend\x12!\n" +
-	"\factual_sales\x18\a \x01(\x02R\vactualSales\"C\n" +
+	"\factual_sales\x18\a \x01(\x03R\vactualSales\"C\n" +
 	"\x10ListUsersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xbe\x01\n" +
@@ -1256,35 +3479,122 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x88\x01\n" +
 	"\x16ListAllProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\"\x98\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06statusJ\x04\b\x03\x10\x04\"\x93\x02\n" +
 	"\x10AdminProductInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x14\n" +
 	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12\x18\n" +
 	"\apicture\x18\x05 \x01(\tR\apicture\x12\x1a\n" +
-	"\bcategory\x18\x06 \x01(\tR\bcategory\"d\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\x03R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1e\n" +
+	"\von_shelf_at\x18\t \x01(\x03R\tonShelfAt\x12 \n" +
+	"\foff_shelf_at\x18\n" +
+	" \x01(\x03R\n" +
+	"offShelfAt\"d\n" +
 	"\x17ListAllProductsResponse\x123\n" +
 	"\bproducts\x18\x01 \x03(\v2\x17.admin.AdminProductInfoR\bproducts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"f\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x8d\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x14\n" +
-	"\x05stock\x18\x04 \x01(\x05R\x05stock\"1\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x19\n" +
+	"\x05stock\x18\x04 \x01(\x05H\x00R\x05stock\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\apicture\x18\x06 \x01(\tH\x02R\apicture\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x03R\n" +
+	"categoryId\x88\x01\x01B\b\n" +
+	"\x06_stockB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_pictureB\x0e\n" +
+	"\f_category_id\"1\n" +
 	"\x15UpdateProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8f\x02\n" +
+	"\x14CreateProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\apicture\x18\x03 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x12'\n" +
+	"\x04skus\x18\x06 \x03(\v2\x13.admin.AdminSkuInfoR\x04skus\x12/\n" +
+	"\x05specs\x18\a \x03(\v2\x19.admin.AdminSpecDimensionR\x05specs\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"'\n" +
+	"\x15CreateProductResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x8e\x02\n" +
+	"\fAdminSkuInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\x05R\x05stock\x12\x18\n" +
+	"\apicture\x18\x06 \x01(\tR\apicture\x12\x16\n" +
+	"\x06weight\x18\a \x01(\x05R\x06weight\x12$\n" +
+	"\x0espec_value_ids\x18\b \x03(\x03R\fspecValueIds\x12\x1f\n" +
+	"\vspec_values\x18\t \x03(\tR\n" +
+	"specValues\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\"g\n" +
+	"\x12AdminSpecDimension\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x06values\x18\x03 \x03(\v2\x15.admin.AdminSpecValueR\x06values\"6\n" +
+	"\x0eAdminSpecValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"0\n" +
+	"\x0fListSkusRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"l\n" +
+	"\x10ListSkusResponse\x12'\n" +
+	"\x04skus\x18\x01 \x03(\v2\x13.admin.AdminSkuInfoR\x04skus\x12/\n" +
+	"\x05specs\x18\x02 \x03(\v2\x19.admin.AdminSpecDimensionR\x05specs\"h\n" +
+	"\x16SetProductSpecsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12/\n" +
+	"\x05specs\x18\x02 \x03(\v2\x19.admin.AdminSpecDimensionR\x05specs\"J\n" +
+	"\x17SetProductSpecsResponse\x12/\n" +
+	"\x05specs\x18\x01 \x03(\v2\x19.admin.AdminSpecDimensionR\x05specs\"7\n" +
+	"\x0eSaveSkuRequest\x12%\n" +
+	"\x03sku\x18\x01 \x01(\v2\x13.admin.AdminSkuInfoR\x03sku\"!\n" +
+	"\x0fSaveSkuResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"A\n" +
+	"\x12SetSkuStockRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\"/\n" +
+	"\x13SetSkuStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x01\n" +
+	"\x17SetProductStatusRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1e\n" +
+	"\von_shelf_at\x18\x03 \x01(\x03R\tonShelfAt\x12 \n" +
+	"\foff_shelf_at\x18\x04 \x01(\x03R\n" +
+	"offShelfAt\"4\n" +
+	"\x18SetProductStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x13SetSkuStatusRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"0\n" +
+	"\x14SetSkuStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
-	"\x11BatchPriceRequest\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
-	"\x05ratio\x18\x02 \x01(\x02R\x05ratio\".\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x11BatchPriceRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bratio_bp\x18\x02 \x01(\x05R\aratioBpJ\x04\b\x01\x10\x02\".\n" +
 	"\x12BatchPriceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
 	"\x10ShipOrderRequest\x12\x19\n" +
@@ -1296,7 +3606,91 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x05R\x05value\"7\n" +
 	"\tTrendStat\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x02R\x06amount2\x91\x05\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xef\x02\n" +
+	"\x10ShippingRuleInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aregions\x18\x03 \x03(\tR\aregions\x12 \n" +
+	"\vdeliverable\x18\x04 \x01(\bR\vdeliverable\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"first_unit\x18\x06 \x01(\x05R\tfirstUnit\x12\x1b\n" +
+	"\tfirst_fee\x18\a \x01(\x03R\bfirstFee\x12'\n" +
+	"\x0fadditional_unit\x18\b \x01(\x05R\x0eadditionalUnit\x12%\n" +
+	"\x0eadditional_fee\x18\t \x01(\x03R\radditionalFee\x12%\n" +
+	"\x0efree_threshold\x18\n" +
+	" \x01(\x03R\rfreeThreshold\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x18\n" +
+	"\aenabled\x18\f \x01(\bR\aenabled\"\x1a\n" +
+	"\x18ListShippingRulesRequest\"J\n" +
+	"\x19ListShippingRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.admin.ShippingRuleInfoR\x05rules\"F\n" +
+	"\x17SaveShippingRuleRequest\x12+\n" +
+	"\x04rule\x18\x01 \x01(\v2\x17.admin.ShippingRuleInfoR\x04rule\"*\n" +
+	"\x18SaveShippingRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"+\n" +
+	"\x19DeleteShippingRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteShippingRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb9\x01\n" +
+	"\fCategoryNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x12#\n" +
+	"\rproduct_count\x18\x05 \x01(\x03R\fproductCount\x12/\n" +
+	"\bchildren\x18\x06 \x03(\v2\x13.admin.CategoryNodeR\bchildren\"\x17\n" +
+	"\x15ListCategoriesRequest\"M\n" +
+	"\x16ListCategoriesResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.admin.CategoryNodeR\n" +
+	"categories\"j\n" +
+	"\x13SaveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\"&\n" +
+	"\x14SaveCategoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x16\n" +
+	"\x14ReindexSearchRequest\"=\n" +
+	"\x15ReindexSearchResponse\x12\x18\n" +
+	"\astarted\x18\x03 \x01(\bR\astartedJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xe3\x03\n" +
+	"\n" +
+	"CouponInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\x06 \x01(\x03R\vmaxDiscount\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x03R\tthreshold\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\x12\x1b\n" +
+	"\tscope_ids\x18\t \x03(\x03R\bscopeIds\x12%\n" +
+	"\x0etotal_quantity\x18\n" +
+	" \x01(\x05R\rtotalQuantity\x12'\n" +
+	"\x0fissued_quantity\x18\v \x01(\x05R\x0eissuedQuantity\x12\x1f\n" +
+	"\vclaim_limit\x18\f \x01(\x05R\n" +
+	"claimLimit\x12\x1b\n" +
+	"\tuse_limit\x18\r \x01(\x05R\buseLimit\x12\x19\n" +
+	"\bstart_at\x18\x0e \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x0f \x01(\x03R\x05endAt\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x10 \x01(\x05R\tvalidDays\x12\x18\n" +
+	"\aenabled\x18\x11 \x01(\bR\aenabled\"E\n" +
+	"\x12ListCouponsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"X\n" +
+	"\x13ListCouponsResponse\x12+\n" +
+	"\acoupons\x18\x01 \x03(\v2\x11.admin.CouponInfoR\acoupons\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\">\n" +
+	"\x11SaveCouponRequest\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.admin.CouponInfoR\x06coupon\"$\n" +
+	"\x12SaveCouponResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xce\x0e\n" +
 	"\fAdminService\x12>\n" +
 	"\x11GetDashboardStats\x12\x13.admin.StatsRequest\x1a\x14.admin.StatsResponse\x12>\n" +
 	"\tListUsers\x12\x17.admin.ListUsersRequest\x1a\x18.admin.ListUsersResponse\x12K\n" +
@@ -1304,10 +3698,27 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x18.admin.DeleteUserRequest\x1a\x19.admin.DeleteUserResponse\x12P\n" +
 	"\x0fListAllProducts\x12\x1d.admin.ListAllProductsRequest\x1a\x1e.admin.ListAllProductsResponse\x12J\n" +
+	"\rCreateProduct\x12\x1b.admin.CreateProductRequest\x1a\x1c.admin.CreateProductResponse\x12J\n" +
 	"\rUpdateProduct\x12\x1b.admin.UpdateProductRequest\x1a\x1c.admin.UpdateProductResponse\x12J\n" +
 	"\rDeleteProduct\x12\x1b.admin.DeleteProductRequest\x1a\x1c.admin.DeleteProductResponse\x12G\n" +
-	"\x10BatchUpdatePrice\x12\x18.admin.BatchPriceRequest\x1a\x19.admin.BatchPriceResponse\x12>\n" +
-	"\tShipOrder\x12\x17.admin.ShipOrderRequest\x1a\x18.admin.ShipOrderResponseB\x1aZ\x18go-ecommerce/proto/adminb\x06proto3"
+	"\x10BatchUpdatePrice\x12\x18.admin.BatchPriceRequest\x1a\x19.admin.BatchPriceResponse\x12;\n" +
+	"\bListSkus\x12\x16.admin.ListSkusRequest\x1a\x17.admin.ListSkusResponse\x12P\n" +
+	"\x0fSetProductSpecs\x12\x1d.admin.SetProductSpecsRequest\x1a\x1e.admin.SetProductSpecsResponse\x128\n" +
+	"\aSaveSku\x12\x15.admin.SaveSkuRequest\x1a\x16.admin.SaveSkuResponse\x12D\n" +
+	"\vSetSkuStock\x12\x19.admin.SetSkuStockRequest\x1a\x1a.admin.SetSkuStockResponse\x12S\n" +
+	"\x10SetProductStatus\x12\x1e.admin.SetProductStatusRequest\x1a\x1f.admin.SetProductStatusResponse\x12G\n" +
+	"\fSetSkuStatus\x12\x1a.admin.SetSkuStatusRequest\x1a\x1b.admin.SetSkuStatusResponse\x12M\n" +
+	"\x0eListCategories\x12\x1c.admin.ListCategoriesRequest\x1a\x1d.admin.ListCategoriesResponse\x12G\n" +
+	"\fSaveCategory\x12\x1a.admin.SaveCategoryRequest\x1a\x1b.admin.SaveCategoryResponse\x12M\n" +
+	"\x0eDeleteCategory\x12\x1c.admin.DeleteCategoryRequest\x1a\x1d.admin.DeleteCategoryResponse\x12J\n" +
+	"\rReindexSearch\x12\x1b.admin.ReindexSearchRequest\x1a\x1c.admin.ReindexSearchResponse\x12>\n" +
+	"\tShipOrder\x12\x17.admin.ShipOrderRequest\x1a\x18.admin.ShipOrderResponse\x12V\n" +
+	"\x11ListShippingRules\x12\x1f.admin.ListShippingRulesRequest\x1a .admin.ListShippingRulesResponse\x12S\n" +
+	"\x10SaveShippingRule\x12\x1e.admin.SaveShippingRuleRequest\x1a\x1f.admin.SaveShippingRuleResponse\x12Y\n" +
+	"\x12DeleteShippingRule\x12 .admin.DeleteShippingRuleRequest\x1a!.admin.DeleteShippingRuleResponse\x12D\n" +
+	"\vListCoupons\x12\x19.admin.ListCouponsRequest\x1a\x1a.admin.ListCouponsResponse\x12A\n" +
+	"\n" +
+	"SaveCoupon\x12\x18.admin.SaveCouponRequest\x1a\x19.admin.SaveCouponResponseB\x1aZ\x18go-ecommerce/proto/adminb\x06proto3"
 
 var (
 	file_proto_admin_admin_proto_rawDescOnce sync.Once
@@ -1321,59 +3732,143 @@ func file_proto_admin_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_admin_proto_rawDescData
 }
 
-var file_proto_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_proto_admin_admin_proto_goTypes = []any{
-	(*StatsRequest)(nil),            // 0: admin.StatsRequest
-	(*StatsResponse)(nil),           // 1: admin.StatsResponse
-	(*ListUsersRequest)(nil),        // 2: admin.ListUsersRequest
-	(*UserInfo)(nil),                // 3: admin.UserInfo
-	(*ListUsersResponse)(nil),       // 4: admin.ListUsersResponse
-	(*ToggleStatusRequest)(nil),     // 5: admin.ToggleStatusRequest
-	(*ToggleStatusResponse)(nil),    // 6: admin.ToggleStatusResponse
-	(*DeleteUserRequest)(nil),       // 7: admin.DeleteUserRequest
-	(*DeleteUserResponse)(nil),      // 8: admin.DeleteUserResponse
-	(*ListAllProductsRequest)(nil),  // 9: admin.ListAllProductsRequest
-	(*AdminProductInfo)(nil),        // 10: admin.AdminProductInfo
-	(*ListAllProductsResponse)(nil), // 11: admin.ListAllProductsResponse
-	(*UpdateProductRequest)(nil),    // 12: admin.UpdateProductRequest
-	(*UpdateProductResponse)(nil),   // 13: admin.UpdateProductResponse
-	(*DeleteProductRequest)(nil),    // 14: admin.DeleteProductRequest
-	(*DeleteProductResponse)(nil),   // 15: admin.DeleteProductResponse
-	(*BatchPriceRequest)(nil),       // 16: admin.BatchPriceRequest
-	(*BatchPriceResponse)(nil),      // 17: admin.BatchPriceResponse
-	(*ShipOrderRequest)(nil),        // 18: admin.ShipOrderRequest
-	(*ShipOrderResponse)(nil),       // 19: admin.ShipOrderResponse
-	(*CategoryStat)(nil),            // 20: admin.CategoryStat
-	(*TrendStat)(nil),               // 21: admin.TrendStat
+	(*StatsRequest)(nil),               // 0: admin.StatsRequest
+	(*StatsResponse)(nil),              // 1: admin.StatsResponse
+	(*ListUsersRequest)(nil),           // 2: admin.ListUsersRequest
+	(*UserInfo)(nil),                   // 3: admin.UserInfo
+	(*ListUsersResponse)(nil),          // 4: admin.ListUsersResponse
+	(*ToggleStatusRequest)(nil),        // 5: admin.ToggleStatusRequest
+	(*ToggleStatusResponse)(nil),       // 6: admin.ToggleStatusResponse
+	(*DeleteUserRequest)(nil),          // 7: admin.DeleteUserRequest
+	(*DeleteUserResponse)(nil),         // 8: admin.DeleteUserResponse
+	(*ListAllProductsRequest)(nil),     // 9: admin.ListAllProductsRequest
+	(*AdminProductInfo)(nil),           // 10: admin.AdminProductInfo
+	(*ListAllProductsResponse)(nil),    // 11: admin.ListAllProductsResponse
+	(*UpdateProductRequest)(nil),       // 12: admin.UpdateProductRequest
+	(*UpdateProductResponse)(nil),      // 13: admin.UpdateProductResponse
+	(*CreateProductRequest)(nil),       // 14: admin.CreateProductRequest
+	(*CreateProductResponse)(nil),      // 15: admin.CreateProductResponse
+	(*AdminSkuInfo)(nil),               // 16: admin.AdminSkuInfo
+	(*AdminSpecDimension)(nil),         // 17: admin.AdminSpecDimension
+	(*AdminSpecValue)(nil),             // 18: admin.AdminSpecValue
+	(*ListSkusRequest)(nil),            // 19: admin.ListSkusRequest
+	(*ListSkusResponse)(nil),           // 20: admin.ListSkusResponse
+	(*SetProductSpecsRequest)(nil),     // 21: admin.SetProductSpecsRequest
+	(*SetProductSpecsResponse)(nil),    // 22: admin.SetProductSpecsResponse
+	(*SaveSkuRequest)(nil),             // 23: admin.SaveSkuRequest
+	(*SaveSkuResponse)(nil),            // 24: admin.SaveSkuResponse
+	(*SetSkuStockRequest)(nil),         // 25: admin.SetSkuStockRequest
+	(*SetSkuStockResponse)(nil),        // 26: admin.SetSkuStockResponse
+	(*SetProductStatusRequest)(nil),    // 27: admin.SetProductStatusRequest
+	(*SetProductStatusResponse)(nil),   // 28: admin.SetProductStatusResponse
+	(*SetSkuStatusRequest)(nil),        // 29: admin.SetSkuStatusRequest
+	(*SetSkuStatusResponse)(nil),       // 30: admin.SetSkuStatusResponse
+	(*DeleteProductRequest)(nil),       // 31: admin.DeleteProductRequest
+	(*DeleteProductResponse)(nil),      // 32: admin.DeleteProductResponse
+	(*BatchPriceRequest)(nil),          // 33: admin.BatchPriceRequest
+	(*BatchPriceResponse)(nil),         // 34: admin.BatchPriceResponse
+	(*ShipOrderRequest)(nil),           // 35: admin.ShipOrderRequest
+	(*ShipOrderResponse)(nil),          // 36: admin.ShipOrderResponse
+	(*CategoryStat)(nil),               // 37: admin.CategoryStat
+	(*TrendStat)(nil),                  // 38: admin.TrendStat
+	(*ShippingRuleInfo)(nil),           // 39: admin.ShippingRuleInfo
+	(*ListShippingRulesRequest)(nil),   // 40: admin.ListShippingRulesRequest
+	(*ListShippingRulesResponse)(nil),  // 41: admin.ListShippingRulesResponse
+	(*SaveShippingRuleRequest)(nil),    // 42: admin.SaveShippingRuleRequest
+	(*SaveShippingRuleResponse)(nil),   // 43: admin.SaveShippingRuleResponse
+	(*DeleteShippingRuleRequest)(nil),  // 44: admin.DeleteShippingRuleRequest
+	(*DeleteShippingRuleResponse)(nil), // 45: admin.DeleteShippingRuleResponse
+	(*CategoryNode)(nil),               // 46: admin.CategoryNode
+	(*ListCategoriesRequest)(nil),      // 47: admin.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),     // 48: admin.ListCategoriesResponse
+	(*SaveCategoryRequest)(nil),        // 49: admin.SaveCategoryRequest
+	(*SaveCategoryResponse)(nil),       // 50: admin.SaveCategoryResponse
+	(*DeleteCategoryRequest)(nil),      // 51: admin.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),     // 52: admin.DeleteCategoryResponse
+	(*ReindexSearchRequest)(nil),       // 53: admin.ReindexSearchRequest
+	(*ReindexSearchResponse)(nil),      // 54: admin.ReindexSearchResponse
+	(*CouponInfo)(nil),                 // 55: admin.CouponInfo
+	(*ListCouponsRequest)(nil),         // 56: admin.ListCouponsRequest
+	(*ListCouponsResponse)(nil),        // 57: admin.ListCouponsResponse
+	(*SaveCouponRequest)(nil),          // 58: admin.SaveCouponRequest
+	(*SaveCouponResponse)(nil),         // 59: admin.SaveCouponResponse
 }
 var file_proto_admin_admin_proto_depIdxs = []int32{
-	20, // 0: admin.StatsResponse.category_stats:type_name -> admin.CategoryStat
-	21, // 1: admin.StatsResponse.sales_trend:type_name -> admin.TrendStat
+	37, // 0: admin.StatsResponse.category_stats:type_name -> admin.CategoryStat
+	38, // 1: admin.StatsResponse.sales_trend:type_name -> admin.TrendStat
 	3,  // 2: admin.ListUsersResponse.users:type_name -> admin.UserInfo
 	10, // 3: admin.ListAllProductsResponse.products:type_name -> admin.AdminProductInfo
-	0,  // 4: admin.AdminService.GetDashboardStats:input_type -> admin.StatsRequest
-	2,  // 5: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
-	5,  // 6: admin.AdminService.ToggleUserStatus:input_type -> admin.ToggleStatusRequest
-	7,  // 7: admin.AdminService.DeleteUser:input_type -> admin.DeleteUserRequest
-	9,  // 8: admin.AdminService.ListAllProducts:input_type -> admin.ListAllProductsRequest
-	12, // 9: admin.AdminService.UpdateProduct:input_type -> admin.UpdateProductRequest
-	14, // 10: admin.AdminService.DeleteProduct:input_type -> admin.DeleteProductRequest
-	16, // 11: admin.AdminService.BatchUpdatePrice:input_type -> admin.BatchPriceRequest
-	18, // 12: admin.AdminService.ShipOrder:input_type -> admin.ShipOrderRequest
-	1,  // 13: admin.AdminService.GetDashboardStats:output_type -> admin.StatsResponse
-	4,  // 14: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
-	6,  // 15: admin.AdminService.ToggleUserStatus:output_type -> admin.ToggleStatusResponse
-	8,  // 16: admin.AdminService.DeleteUser:output_type -> admin.DeleteUserResponse
-	11, // 17: admin.AdminService.ListAllProducts:output_type -> admin.ListAllProductsResponse
-	13, // 18: admin.AdminService.UpdateProduct:output_type -> admin.UpdateProductResponse
-	15, // 19: admin.AdminService.DeleteProduct:output_type -> admin.DeleteProductResponse
-	17, // 20: admin.AdminService.BatchUpdatePrice:output_type -> admin.BatchPriceResponse
-	19, // 21: admin.AdminService.ShipOrder:output_type -> admin.ShipOrderResponse
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 4: admin.CreateProductRequest.skus:type_name -> admin.AdminSkuInfo
+	17, // 5: admin.CreateProductRequest.specs:type_name -> admin.AdminSpecDimension
+	18, // 6: admin.AdminSpecDimension.values:type_name -> admin.AdminSpecValue
+	16, // 7: admin.ListSkusResponse.skus:type_name -> admin.AdminSkuInfo
+	17, // 8: admin.ListSkusResponse.specs:type_name -> admin.AdminSpecDimension
+	17, // 9: admin.SetProductSpecsRequest.specs:type_name -> admin.AdminSpecDimension
+	17, // 10: admin.SetProductSpecsResponse.specs:type_name -> admin.AdminSpecDimension
+	16, // 11: admin.SaveSkuRequest.sku:type_name -> admin.AdminSkuInfo
+	39, // 12: admin.ListShippingRulesResponse.rules:type_name -> admin.ShippingRuleInfo
+	39, // 13: admin.SaveShippingRuleRequest.rule:type_name -> admin.ShippingRuleInfo
+	46, // 14: admin.CategoryNode.children:type_name -> admin.CategoryNode
+	46, // 15: admin.ListCategoriesResponse.categories:type_name -> admin.CategoryNode
+	55, // 16: admin.ListCouponsResponse.coupons:type_name -> admin.CouponInfo
+	55, // 17: admin.SaveCouponRequest.coupon:type_name -> admin.CouponInfo
+	0,  // 18: admin.AdminService.GetDashboardStats:input_type -> admin.StatsRequest
+	2,  // 19: admin.AdminService.ListUsers:input_type -> admin.ListUsersRequest
+	5,  // 20: admin.AdminService.ToggleUserStatus:input_type -> admin.ToggleStatusRequest
+	7,  // 21: admin.AdminService.DeleteUser:input_type -> admin.DeleteUserRequest
+	9,  // 22: admin.AdminService.ListAllProducts:input_type -> admin.ListAllProductsRequest
+	14, // 23: admin.AdminService.CreateProduct:input_type -> admin.CreateProductRequest
+	12, // 24: admin.AdminService.UpdateProduct:input_type -> admin.UpdateProductRequest
+	31, // 25: admin.AdminService.DeleteProduct:input_type -> admin.DeleteProductRequest
+	33, // 26: admin.AdminService.BatchUpdatePrice:input_type -> admin.BatchPriceRequest
+	19, // 27: admin.AdminService.ListSkus:input_type -> admin.ListSkusRequest
+	21, // 28: admin.AdminService.SetProductSpecs:input_type -> admin.SetProductSpecsRequest
+	23, // 29: admin.AdminService.SaveSku:input_type -> admin.SaveSkuRequest
+	25, // 30: admin.AdminService.SetSkuStock:input_type -> admin.SetSkuStockRequest
+	27, // 31: admin.AdminService.SetProductStatus:input_type -> admin.SetProductStatusRequest
+	29, // 32: admin.AdminService.SetSkuStatus:input_type -> admin.SetSkuStatusRequest
+	47, // 33: admin.AdminService.ListCategories:input_type -> admin.ListCategoriesRequest
+	49, // 34: admin.AdminService.SaveCategory:input_type -> admin.SaveCategoryRequest
+	51, // 35: admin.AdminService.DeleteCategory:input_type -> admin.DeleteCategoryRequest
+	53, // 36: admin.AdminService.ReindexSearch:input_type -> admin.ReindexSearchRequest
+	35, // 37: admin.AdminService.ShipOrder:input_type -> admin.ShipOrderRequest
+	40, // 38: admin.AdminService.ListShippingRules:input_type -> admin.ListShippingRulesRequest
+	42, // 39: admin.AdminService.SaveShippingRule:input_type -> admin.SaveShippingRuleRequest
+	44, // 40: admin.AdminService.DeleteShippingRule:input_type -> admin.DeleteShippingRuleRequest
+	56, // 41: admin.AdminService.ListCoupons:input_type -> admin.ListCouponsRequest
+	58, // 42: admin.AdminService.SaveCoupon:input_type -> admin.SaveCouponRequest
+	1,  // 43: admin.AdminService.GetDashboardStats:output_type -> admin.StatsResponse
+	4,  // 44: admin.AdminService.ListUsers:output_type -> admin.ListUsersResponse
+	6,  // 45: admin.AdminService.ToggleUserStatus:output_type -> admin.ToggleStatusResponse
+	8,  // 46: admin.AdminService.DeleteUser:output_type -> admin.DeleteUserResponse
+	11, // 47: admin.AdminService.ListAllProducts:output_type -> admin.ListAllProductsResponse
+	15, // 48: admin.AdminService.CreateProduct:output_type -> admin.CreateProductResponse
+	13, // 49: admin.AdminService.UpdateProduct:output_type -> admin.UpdateProductResponse
+	32, // 50: admin.AdminService.DeleteProduct:output_type -> admin.DeleteProductResponse
+	34, // 51: admin.AdminService.BatchUpdatePrice:output_type -> admin.BatchPriceResponse
+	20, // 52: admin.AdminService.ListSkus:output_type -> admin.ListSkusResponse
+	22, // 53: admin.AdminService.SetProductSpecs:output_type -> admin.SetProductSpecsResponse
+	24, // 54: admin.AdminService.SaveSku:output_type -> admin.SaveSkuResponse
+	26, // 55: admin.AdminService.SetSkuStock:output_type -> admin.SetSkuStockResponse
+	28, // 56: admin.AdminService.SetProductStatus:output_type -> admin.SetProductStatusResponse
+	30, // 57: admin.AdminService.SetSkuStatus:output_type -> admin.SetSkuStatusResponse
+	48, // 58: admin.AdminService.ListCategories:output_type -> admin.ListCategoriesResponse
+	50, // 59: admin.AdminService.SaveCategory:output_type -> admin.SaveCategoryResponse
+	52, // 60: admin.AdminService.DeleteCategory:output_type -> admin.DeleteCategoryResponse
+	54, // 61: admin.AdminService.ReindexSearch:output_type -> admin.ReindexSearchResponse
+	36, // 62: admin.AdminService.ShipOrder:output_type -> admin.ShipOrderResponse
+	41, // 63: admin.AdminService.ListShippingRules:output_type -> admin.ListShippingRulesResponse
+	43, // 64: admin.AdminService.SaveShippingRule:output_type -> admin.SaveShippingRuleResponse
+	45, // 65: admin.AdminService.DeleteShippingRule:output_type -> admin.DeleteShippingRuleResponse
+	57, // 66: admin.AdminService.ListCoupons:output_type -> admin.ListCouponsResponse
+	59, // 67: admin.AdminService.SaveCoupon:output_type -> admin.SaveCouponResponse
+	43, // [43:68] is the sub-list for method output_type
+	18, // [18:43] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_admin_proto_init() }
@@ -1381,13 +3876,14 @@ func file_proto_admin_admin_proto_init() {
 	if File_proto_admin_admin_proto != nil {
 		return
 	}
+	file_proto_admin_admin_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_admin_proto_rawDesc), len(file_proto_admin_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
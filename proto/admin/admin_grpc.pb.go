@@ -19,15 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetDashboardStats_FullMethodName = "/admin.AdminService/GetDashboardStats"
-	AdminService_ListUsers_FullMethodName         = "/admin.AdminService/ListUsers"
-	AdminService_ToggleUserStatus_FullMethodName  = "/admin.AdminService/ToggleUserStatus"
-	AdminService_DeleteUser_FullMethodName        = "/admin.AdminService/DeleteUser"
-	AdminService_ListAllProducts_FullMethodName   = "/admin.AdminService/ListAllProducts"
-	AdminService_UpdateProduct_FullMethodName     = "/admin.AdminService/UpdateProduct"
-	AdminService_DeleteProduct_FullMethodName     = "/admin.AdminService/DeleteProduct"
-	AdminService_BatchUpdatePrice_FullMethodName  = "/admin.AdminService/BatchUpdatePrice"
-	AdminService_ShipOrder_FullMethodName         = "/admin.AdminService/ShipOrder"
+	AdminService_GetDashboardStats_FullMethodName  = "/admin.AdminService/GetDashboardStats"
+	AdminService_ListUsers_FullMethodName          = "/admin.AdminService/ListUsers"
+	AdminService_ToggleUserStatus_FullMethodName   = "/admin.AdminService/ToggleUserStatus"
+	AdminService_DeleteUser_FullMethodName         = "/admin.AdminService/DeleteUser"
+	AdminService_ListAllProducts_FullMethodName    = "/admin.AdminService/ListAllProducts"
+	AdminService_CreateProduct_FullMethodName      = "/admin.AdminService/CreateProduct"
+	AdminService_UpdateProduct_FullMethodName      = "/admin.AdminService/UpdateProduct"
+	AdminService_DeleteProduct_FullMethodName      = "/admin.AdminService/DeleteProduct"
+	AdminService_BatchUpdatePrice_FullMethodName   = "/admin.AdminService/BatchUpdatePrice"
+	AdminService_ListSkus_FullMethodName           = "/admin.AdminService/ListSkus"
+	AdminService_SetProductSpecs_FullMethodName    = "/admin.AdminService/SetProductSpecs"
+	AdminService_SaveSku_FullMethodName            = "/admin.AdminService/SaveSku"
+	AdminService_SetSkuStock_FullMethodName        = "/admin.AdminService/SetSkuStock"
+	AdminService_SetProductStatus_FullMethodName   = "/admin.AdminService/SetProductStatus"
+	AdminService_SetSkuStatus_FullMethodName       = "/admin.AdminService/SetSkuStatus"
+	AdminService_ListCategories_FullMethodName     = "/admin.AdminService/ListCategories"
+	AdminService_SaveCategory_FullMethodName       = "/admin.AdminService/SaveCategory"
+	AdminService_DeleteCategory_FullMethodName     = "/admin.AdminService/DeleteCategory"
+	AdminService_ReindexSearch_FullMethodName      = "/admin.AdminService/ReindexSearch"
+	AdminService_ShipOrder_FullMethodName          = "/admin.AdminService/ShipOrder"
+	AdminService_ListShippingRules_FullMethodName  = "/admin.AdminService/ListShippingRules"
+	AdminService_SaveShippingRule_FullMethodName   = "/admin.AdminService/SaveShippingRule"
+	AdminService_DeleteShippingRule_FullMethodName = "/admin.AdminService/DeleteShippingRule"
+	AdminService_ListCoupons_FullMethodName        = "/admin.AdminService/ListCoupons"
+	AdminService_SaveCoupon_FullMethodName         = "/admin.AdminService/SaveCoupon"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -40,13 +56,33 @@ type AdminServiceClient interface {
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	ToggleUserStatus(ctx context.Context, in *ToggleStatusRequest, opts ...grpc.CallOption) (*ToggleStatusResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
-	// --- 商品管理 ---
+	// --- 商品管理 (写操作均调用 Product Service) ---
 	ListAllProducts(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (*ListAllProductsResponse, error)
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 	BatchUpdatePrice(ctx context.Context, in *BatchPriceRequest, opts ...grpc.CallOption) (*BatchPriceResponse, error)
+	ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error)
+	SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*SetProductSpecsResponse, error)
+	SaveSku(ctx context.Context, in *SaveSkuRequest, opts ...grpc.CallOption) (*SaveSkuResponse, error)
+	SetSkuStock(ctx context.Context, in *SetSkuStockRequest, opts ...grpc.CallOption) (*SetSkuStockResponse, error)
+	SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error)
+	SetSkuStatus(ctx context.Context, in *SetSkuStatusRequest, opts ...grpc.CallOption) (*SetSkuStatusResponse, error)
+	// --- 分类管理 (由 Product Service 维护) ---
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	SaveCategory(ctx context.Context, in *SaveCategoryRequest, opts ...grpc.CallOption) (*SaveCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// --- 搜索索引 ---
+	ReindexSearch(ctx context.Context, in *ReindexSearchRequest, opts ...grpc.CallOption) (*ReindexSearchResponse, error)
 	// --- 订单管理 ---
 	ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error)
+	// --- 运费规则 ---
+	ListShippingRules(ctx context.Context, in *ListShippingRulesRequest, opts ...grpc.CallOption) (*ListShippingRulesResponse, error)
+	SaveShippingRule(ctx context.Context, in *SaveShippingRuleRequest, opts ...grpc.CallOption) (*SaveShippingRuleResponse, error)
+	DeleteShippingRule(ctx context.Context, in *DeleteShippingRuleRequest, opts ...grpc.CallOption) (*DeleteShippingRuleResponse, error)
+	// --- 优惠券 (由 Promotion Service 维护) ---
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	SaveCoupon(ctx context.Context, in *SaveCouponRequest, opts ...grpc.CallOption) (*SaveCouponResponse, error)
 }
 
 type adminServiceClient struct {
@@ -107,6 +143,16 @@ func (c *adminServiceClient) ListAllProducts(ctx context.Context, in *ListAllPro
 	return out, nil
 }
 
+func (c *adminServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProductResponse)
@@ -137,6 +183,106 @@ func (c *adminServiceClient) BatchUpdatePrice(ctx context.Context, in *BatchPric
 	return out, nil
 }
 
+func (c *adminServiceClient) ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkusResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSkus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*SetProductSpecsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductSpecsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetProductSpecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveSku(ctx context.Context, in *SaveSkuRequest, opts ...grpc.CallOption) (*SaveSkuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSkuResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetSkuStock(ctx context.Context, in *SetSkuStockRequest, opts ...grpc.CallOption) (*SetSkuStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSkuStockResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetSkuStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetProductStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetSkuStatus(ctx context.Context, in *SetSkuStatusRequest, opts ...grpc.CallOption) (*SetSkuStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSkuStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetSkuStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveCategory(ctx context.Context, in *SaveCategoryRequest, opts ...grpc.CallOption) (*SaveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCategoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReindexSearch(ctx context.Context, in *ReindexSearchRequest, opts ...grpc.CallOption) (*ReindexSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReindexSearchResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReindexSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ShipOrderResponse)
@@ -147,6 +293,56 @@ func (c *adminServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) ListShippingRules(ctx context.Context, in *ListShippingRulesRequest, opts ...grpc.CallOption) (*ListShippingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShippingRulesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListShippingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveShippingRule(ctx context.Context, in *SaveShippingRuleRequest, opts ...grpc.CallOption) (*SaveShippingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveShippingRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveShippingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteShippingRule(ctx context.Context, in *DeleteShippingRuleRequest, opts ...grpc.CallOption) (*DeleteShippingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShippingRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteShippingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveCoupon(ctx context.Context, in *SaveCouponRequest, opts ...grpc.CallOption) (*SaveCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCouponResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -157,13 +353,33 @@ type AdminServiceServer interface {
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	ToggleUserStatus(context.Context, *ToggleStatusRequest) (*ToggleStatusResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	// --- 商品管理 ---
+	// --- 商品管理 (写操作均调用 Product Service) ---
 	ListAllProducts(context.Context, *ListAllProductsRequest) (*ListAllProductsResponse, error)
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
 	BatchUpdatePrice(context.Context, *BatchPriceRequest) (*BatchPriceResponse, error)
+	ListSkus(context.Context, *ListSkusRequest) (*ListSkusResponse, error)
+	SetProductSpecs(context.Context, *SetProductSpecsRequest) (*SetProductSpecsResponse, error)
+	SaveSku(context.Context, *SaveSkuRequest) (*SaveSkuResponse, error)
+	SetSkuStock(context.Context, *SetSkuStockRequest) (*SetSkuStockResponse, error)
+	SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error)
+	SetSkuStatus(context.Context, *SetSkuStatusRequest) (*SetSkuStatusResponse, error)
+	// --- 分类管理 (由 Product Service 维护) ---
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	SaveCategory(context.Context, *SaveCategoryRequest) (*SaveCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// --- 搜索索引 ---
+	ReindexSearch(context.Context, *ReindexSearchRequest) (*ReindexSearchResponse, error)
 	// --- 订单管理 ---
 	ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error)
+	// --- 运费规则 ---
+	ListShippingRules(context.Context, *ListShippingRulesRequest) (*ListShippingRulesResponse, error)
+	SaveShippingRule(context.Context, *SaveShippingRuleRequest) (*SaveShippingRuleResponse, error)
+	DeleteShippingRule(context.Context, *DeleteShippingRuleRequest) (*DeleteShippingRuleResponse, error)
+	// --- 优惠券 (由 Promotion Service 维护) ---
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	SaveCoupon(context.Context, *SaveCouponRequest) (*SaveCouponResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -189,6 +405,9 @@ func (UnimplementedAdminServiceServer) DeleteUser(context.Context, *DeleteUserRe
 func (UnimplementedAdminServiceServer) ListAllProducts(context.Context, *ListAllProductsRequest) (*ListAllProductsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAllProducts not implemented")
 }
+func (UnimplementedAdminServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
 func (UnimplementedAdminServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
 }
@@ -198,9 +417,54 @@ func (UnimplementedAdminServiceServer) DeleteProduct(context.Context, *DeletePro
 func (UnimplementedAdminServiceServer) BatchUpdatePrice(context.Context, *BatchPriceRequest) (*BatchPriceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchUpdatePrice not implemented")
 }
+func (UnimplementedAdminServiceServer) ListSkus(context.Context, *ListSkusRequest) (*ListSkusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSkus not implemented")
+}
+func (UnimplementedAdminServiceServer) SetProductSpecs(context.Context, *SetProductSpecsRequest) (*SetProductSpecsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductSpecs not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveSku(context.Context, *SaveSkuRequest) (*SaveSkuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveSku not implemented")
+}
+func (UnimplementedAdminServiceServer) SetSkuStock(context.Context, *SetSkuStockRequest) (*SetSkuStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSkuStock not implemented")
+}
+func (UnimplementedAdminServiceServer) SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) SetSkuStatus(context.Context, *SetSkuStatusRequest) (*SetSkuStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSkuStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveCategory(context.Context, *SaveCategoryRequest) (*SaveCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCategory not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedAdminServiceServer) ReindexSearch(context.Context, *ReindexSearchRequest) (*ReindexSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReindexSearch not implemented")
+}
 func (UnimplementedAdminServiceServer) ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ShipOrder not implemented")
 }
+func (UnimplementedAdminServiceServer) ListShippingRules(context.Context, *ListShippingRulesRequest) (*ListShippingRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShippingRules not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveShippingRule(context.Context, *SaveShippingRuleRequest) (*SaveShippingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveShippingRule not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteShippingRule(context.Context, *DeleteShippingRuleRequest) (*DeleteShippingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteShippingRule not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveCoupon(context.Context, *SaveCouponRequest) (*SaveCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCoupon not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -312,6 +576,24 @@ func _AdminService_ListAllProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProductRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +648,186 @@ func _AdminService_BatchUpdatePrice_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSkus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSkus(ctx, req.(*ListSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetProductSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetProductSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetProductSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetProductSpecs(ctx, req.(*SetProductSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveSku(ctx, req.(*SaveSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetSkuStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkuStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetSkuStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetSkuStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetSkuStock(ctx, req.(*SetSkuStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetProductStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetProductStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetProductStatus(ctx, req.(*SetProductStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetSkuStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkuStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetSkuStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetSkuStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetSkuStatus(ctx, req.(*SetSkuStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveCategory(ctx, req.(*SaveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReindexSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReindexSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReindexSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReindexSearch(ctx, req.(*ReindexSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShipOrderRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +846,96 @@ func _AdminService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListShippingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShippingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListShippingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListShippingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListShippingRules(ctx, req.(*ListShippingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveShippingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveShippingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveShippingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveShippingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveShippingRule(ctx, req.(*SaveShippingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteShippingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShippingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteShippingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteShippingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteShippingRule(ctx, req.(*DeleteShippingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveCoupon(ctx, req.(*SaveCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +963,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllProducts",
 			Handler:    _AdminService_ListAllProducts_Handler,
 		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _AdminService_CreateProduct_Handler,
+		},
 		{
 			MethodName: "UpdateProduct",
 			Handler:    _AdminService_UpdateProduct_Handler,
@@ -423,10 +979,70 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpdatePrice",
 			Handler:    _AdminService_BatchUpdatePrice_Handler,
 		},
+		{
+			MethodName: "ListSkus",
+			Handler:    _AdminService_ListSkus_Handler,
+		},
+		{
+			MethodName: "SetProductSpecs",
+			Handler:    _AdminService_SetProductSpecs_Handler,
+		},
+		{
+			MethodName: "SaveSku",
+			Handler:    _AdminService_SaveSku_Handler,
+		},
+		{
+			MethodName: "SetSkuStock",
+			Handler:    _AdminService_SetSkuStock_Handler,
+		},
+		{
+			MethodName: "SetProductStatus",
+			Handler:    _AdminService_SetProductStatus_Handler,
+		},
+		{
+			MethodName: "SetSkuStatus",
+			Handler:    _AdminService_SetSkuStatus_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _AdminService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SaveCategory",
+			Handler:    _AdminService_SaveCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _AdminService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ReindexSearch",
+			Handler:    _AdminService_ReindexSearch_Handler,
+		},
 		{
 			MethodName: "ShipOrder",
 			Handler:    _AdminService_ShipOrder_Handler,
 		},
+		{
+			MethodName: "ListShippingRules",
+			Handler:    _AdminService_ListShippingRules_Handler,
+		},
+		{
+			MethodName: "SaveShippingRule",
+			Handler:    _AdminService_SaveShippingRule_Handler,
+		},
+		{
+			MethodName: "DeleteShippingRule",
+			Handler:    _AdminService_DeleteShippingRule_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _AdminService_ListCoupons_Handler,
+		},
+		{
+			MethodName: "SaveCoupon",
+			Handler:    _AdminService_SaveCoupon_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/admin/admin.proto",
@@ -81,10 +81,12 @@ func (x *CartItem) GetQuantity() int32 {
 	return 0
 }
 
+// 购物车归属：user_id 为 0 时使用 guest_id (游客购物车，由网关签发)
 type AddItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Item          *CartItem              `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	GuestId       string                 `protobuf:"bytes,3,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +135,13 @@ func (x *AddItemRequest) GetItem() *CartItem {
 	return nil
 }
 
+func (x *AddItemRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
 type AddItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -188,6 +197,7 @@ func (x *AddItemResponse) GetMsg() string {
 type GetCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestId       string                 `protobuf:"bytes,2,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,6 +239,13 @@ func (x *GetCartRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetCartRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
 type GetCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -365,6 +382,7 @@ type DeleteItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	GuestId       string                 `protobuf:"bytes,3,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +431,13 @@ func (x *DeleteItemRequest) GetSkuId() int64 {
 	return 0
 }
 
+func (x *DeleteItemRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
 type DeleteItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -465,42 +490,1066 @@ func (x *DeleteItemResponse) GetMsg() string {
 	return ""
 }
 
-var File_proto_cart_cart_proto protoreflect.FileDescriptor
+type CartItemDetail struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SkuId             int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SkuName           string                 `protobuf:"bytes,4,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	Picture           string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price             int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`                             // 当前价格
+	AddedPrice        int64                  `protobuf:"varint,7,opt,name=added_price,json=addedPrice,proto3" json:"added_price,omitempty"` // 加入购物车时的价格，0 表示未记录
+	Quantity          int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Stock             int32                  `protobuf:"varint,9,opt,name=stock,proto3" json:"stock,omitempty"`
+	Selected          bool                   `protobuf:"varint,10,opt,name=selected,proto3" json:"selected,omitempty"`
+	SoldOut           bool                   `protobuf:"varint,11,opt,name=sold_out,json=soldOut,proto3" json:"sold_out,omitempty"`                               // 库存为 0
+	Deleted           bool                   `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`                                              // 商品已删除
+	PriceChanged      bool                   `protobuf:"varint,13,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`                // 价格与加入时不同
+	StockInsufficient bool                   `protobuf:"varint,14,opt,name=stock_insufficient,json=stockInsufficient,proto3" json:"stock_insufficient,omitempty"` // 购买数量超过库存
+	Subtotal          int64                  `protobuf:"varint,15,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                                            // price * quantity
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CartItemDetail) Reset() {
+	*x = CartItemDetail{}
+	mi := &file_proto_cart_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_proto_cart_cart_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/cart/cart.proto\x12\x04cart\"\\\n" +
-	"\bCartItem\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"M\n" +
-	"\x0eAddItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
-	"\x04item\x18\x02 \x01(\v2\x0e.cart.CartItemR\x04item\"7\n" +
-	"\x0fAddItemResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\")\n" +
-	"\x0eGetCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"7\n" +
-	"\x0fGetCartResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\"+\n" +
-	"\x10EmptyCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
-	"\x11EmptyCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"C\n" +
-	"\x11DeleteItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\":\n" +
-	"\x12DeleteItemResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg2\xfc\x01\n" +
+func (x *CartItemDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemDetail) ProtoMessage() {}
+
+func (x *CartItemDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemDetail.ProtoReflect.Descriptor instead.
+func (*CartItemDetail) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CartItemDetail) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItemDetail) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *CartItemDetail) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *CartItemDetail) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetAddedPrice() int64 {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CartItemDetail) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *CartItemDetail) GetSoldOut() bool {
+	if x != nil {
+		return x.SoldOut
+	}
+	return false
+}
+
+func (x *CartItemDetail) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *CartItemDetail) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartItemDetail) GetStockInsufficient() bool {
+	if x != nil {
+		return x.StockInsufficient
+	}
+	return false
+}
+
+func (x *CartItemDetail) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+type GetCartDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestId       string                 `protobuf:"bytes,2,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartDetailRequest) Reset() {
+	*x = GetCartDetailRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartDetailRequest) ProtoMessage() {}
+
+func (x *GetCartDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetCartDetailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCartDetailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCartDetailRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type GetCartDetailResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Items            []*CartItemDetail      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	SelectedCount    int32                  `protobuf:"varint,2,opt,name=selected_count,json=selectedCount,proto3" json:"selected_count,omitempty"`          // 勾选的有效商品行数
+	SelectedQuantity int32                  `protobuf:"varint,3,opt,name=selected_quantity,json=selectedQuantity,proto3" json:"selected_quantity,omitempty"` // 勾选的有效商品件数
+	SelectedAmount   int64                  `protobuf:"varint,4,opt,name=selected_amount,json=selectedAmount,proto3" json:"selected_amount,omitempty"`       // 勾选的有效商品小计 (已删除、售罄商品不计入)
+	TotalQuantity    int32                  `protobuf:"varint,5,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCartDetailResponse) Reset() {
+	*x = GetCartDetailResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartDetailResponse) ProtoMessage() {}
+
+func (x *GetCartDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetCartDetailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCartDetailResponse) GetItems() []*CartItemDetail {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetCartDetailResponse) GetSelectedCount() int32 {
+	if x != nil {
+		return x.SelectedCount
+	}
+	return 0
+}
+
+func (x *GetCartDetailResponse) GetSelectedQuantity() int32 {
+	if x != nil {
+		return x.SelectedQuantity
+	}
+	return 0
+}
+
+func (x *GetCartDetailResponse) GetSelectedAmount() int64 {
+	if x != nil {
+		return x.SelectedAmount
+	}
+	return 0
+}
+
+func (x *GetCartDetailResponse) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+type SelectItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	Selected      bool                   `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"` // 为 true 时忽略 sku_ids，作用于整个购物车
+	GuestId       string                 `protobuf:"bytes,5,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectItemsRequest) Reset() {
+	*x = SelectItemsRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectItemsRequest) ProtoMessage() {}
+
+func (x *SelectItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectItemsRequest.ProtoReflect.Descriptor instead.
+func (*SelectItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SelectItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelectItemsRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *SelectItemsRequest) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *SelectItemsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *SelectItemsRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type SelectItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectItemsResponse) Reset() {
+	*x = SelectItemsResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectItemsResponse) ProtoMessage() {}
+
+func (x *SelectItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectItemsResponse.ProtoReflect.Descriptor instead.
+func (*SelectItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SelectItemsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateItemQuantityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	GuestId       string                 `protobuf:"bytes,4,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemQuantityRequest) Reset() {
+	*x = UpdateItemQuantityRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemQuantityRequest) ProtoMessage() {}
+
+func (x *UpdateItemQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateItemQuantityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateItemQuantityRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *UpdateItemQuantityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateItemQuantityRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type UpdateItemQuantityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantity      int32                  `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"` // 修改后的数量，0 表示已移除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemQuantityResponse) Reset() {
+	*x = UpdateItemQuantityResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemQuantityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemQuantityResponse) ProtoMessage() {}
+
+func (x *UpdateItemQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemQuantityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateItemQuantityResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type MergeCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartRequest) Reset() {
+	*x = MergeCartRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartRequest) ProtoMessage() {}
+
+func (x *MergeCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MergeCartRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *MergeCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type MergeCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merged        int32                  `protobuf:"varint,1,opt,name=merged,proto3" json:"merged,omitempty"`   // 合并成功的商品行数
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"` // 因失效、售罄或超出购物车上限被丢弃的行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartResponse) Reset() {
+	*x = MergeCartResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartResponse) ProtoMessage() {}
+
+func (x *MergeCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartResponse.ProtoReflect.Descriptor instead.
+func (*MergeCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MergeCartResponse) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *MergeCartResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type CreateCheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"` // 为空时使用购物车中已勾选的商品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCheckoutRequest) Reset() {
+	*x = CreateCheckoutRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCheckoutRequest) ProtoMessage() {}
+
+func (x *CreateCheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateCheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCheckoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCheckoutRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type CheckoutInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckoutId    string                 `protobuf:"bytes,1,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 创建快照时的购物车版本号
+	Items         []*CartItem            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 快照过期时间 (Unix 秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutInfo) Reset() {
+	*x = CheckoutInfo{}
+	mi := &file_proto_cart_cart_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutInfo) ProtoMessage() {}
+
+func (x *CheckoutInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutInfo.ProtoReflect.Descriptor instead.
+func (*CheckoutInfo) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckoutInfo) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+func (x *CheckoutInfo) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CheckoutInfo) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CheckoutInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type LockCheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CheckoutId    string                 `protobuf:"bytes,2,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockCheckoutRequest) Reset() {
+	*x = LockCheckoutRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockCheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCheckoutRequest) ProtoMessage() {}
+
+func (x *LockCheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCheckoutRequest.ProtoReflect.Descriptor instead.
+func (*LockCheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LockCheckoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LockCheckoutRequest) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+type UnlockCheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CheckoutId    string                 `protobuf:"bytes,2,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockCheckoutRequest) Reset() {
+	*x = UnlockCheckoutRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockCheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCheckoutRequest) ProtoMessage() {}
+
+func (x *UnlockCheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCheckoutRequest.ProtoReflect.Descriptor instead.
+func (*UnlockCheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnlockCheckoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnlockCheckoutRequest) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+type UnlockCheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockCheckoutResponse) Reset() {
+	*x = UnlockCheckoutResponse{}
+	mi := &file_proto_cart_cart_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockCheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCheckoutResponse) ProtoMessage() {}
+
+func (x *UnlockCheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCheckoutResponse.ProtoReflect.Descriptor instead.
+func (*UnlockCheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnlockCheckoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CheckoutId    string                 `protobuf:"bytes,2,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckoutRequest) Reset() {
+	*x = GetCheckoutRequest{}
+	mi := &file_proto_cart_cart_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckoutRequest) ProtoMessage() {}
+
+func (x *GetCheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cart_cart_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckoutRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cart_cart_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCheckoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCheckoutRequest) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+var File_proto_cart_cart_proto protoreflect.FileDescriptor
+
+const file_proto_cart_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/cart/cart.proto\x12\x04cart\"\\\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"h\n" +
+	"\x0eAddItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\x04item\x18\x02 \x01(\v2\x0e.cart.CartItemR\x04item\x12\x19\n" +
+	"\bguest_id\x18\x03 \x01(\tR\aguestId\"7\n" +
+	"\x0fAddItemResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"D\n" +
+	"\x0eGetCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bguest_id\x18\x02 \x01(\tR\aguestId\"7\n" +
+	"\x0fGetCartResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\"+\n" +
+	"\x10EmptyCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
+	"\x11EmptyCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"^\n" +
+	"\x11DeleteItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x19\n" +
+	"\bguest_id\x18\x03 \x01(\tR\aguestId\":\n" +
+	"\x12DeleteItemResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xb9\x03\n" +
+	"\x0eCartItemDetail\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x1f\n" +
+	"\vadded_price\x18\a \x01(\x03R\n" +
+	"addedPrice\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05stock\x18\t \x01(\x05R\x05stock\x12\x1a\n" +
+	"\bselected\x18\n" +
+	" \x01(\bR\bselected\x12\x19\n" +
+	"\bsold_out\x18\v \x01(\bR\asoldOut\x12\x18\n" +
+	"\adeleted\x18\f \x01(\bR\adeleted\x12#\n" +
+	"\rprice_changed\x18\r \x01(\bR\fpriceChanged\x12-\n" +
+	"\x12stock_insufficient\x18\x0e \x01(\bR\x11stockInsufficient\x12\x1a\n" +
+	"\bsubtotal\x18\x0f \x01(\x03R\bsubtotal\"J\n" +
+	"\x14GetCartDetailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bguest_id\x18\x02 \x01(\tR\aguestId\"\xe7\x01\n" +
+	"\x15GetCartDetailResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.cart.CartItemDetailR\x05items\x12%\n" +
+	"\x0eselected_count\x18\x02 \x01(\x05R\rselectedCount\x12+\n" +
+	"\x11selected_quantity\x18\x03 \x01(\x05R\x10selectedQuantity\x12'\n" +
+	"\x0fselected_amount\x18\x04 \x01(\x03R\x0eselectedAmount\x12%\n" +
+	"\x0etotal_quantity\x18\x05 \x01(\x05R\rtotalQuantity\"\x8f\x01\n" +
+	"\x12SelectItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\x03R\x06skuIds\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\x12\x10\n" +
+	"\x03all\x18\x04 \x01(\bR\x03all\x12\x19\n" +
+	"\bguest_id\x18\x05 \x01(\tR\aguestId\"/\n" +
+	"\x13SelectItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x82\x01\n" +
+	"\x19UpdateItemQuantityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bguest_id\x18\x04 \x01(\tR\aguestId\"8\n" +
+	"\x1aUpdateItemQuantityResponse\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x05R\bquantity\"F\n" +
+	"\x10MergeCartRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"E\n" +
+	"\x11MergeCartResponse\x12\x16\n" +
+	"\x06merged\x18\x01 \x01(\x05R\x06merged\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\"I\n" +
+	"\x15CreateCheckoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\x03R\x06skuIds\"\x8e\x01\n" +
+	"\fCheckoutInfo\x12\x1f\n" +
+	"\vcheckout_id\x18\x01 \x01(\tR\n" +
+	"checkoutId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12$\n" +
+	"\x05items\x18\x03 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"O\n" +
+	"\x13LockCheckoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcheckout_id\x18\x02 \x01(\tR\n" +
+	"checkoutId\"Q\n" +
+	"\x15UnlockCheckoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcheckout_id\x18\x02 \x01(\tR\n" +
+	"checkoutId\"2\n" +
+	"\x16UnlockCheckoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x12GetCheckoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcheckout_id\x18\x02 \x01(\tR\n" +
+	"checkoutId2\xad\x06\n" +
 	"\vCartService\x126\n" +
 	"\aAddItem\x12\x14.cart.AddItemRequest\x1a\x15.cart.AddItemResponse\x126\n" +
 	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
 	"\tEmptyCart\x12\x16.cart.EmptyCartRequest\x1a\x17.cart.EmptyCartResponse\x12?\n" +
 	"\n" +
-	"DeleteItem\x12\x17.cart.DeleteItemRequest\x1a\x18.cart.DeleteItemResponseB\x1eZ\x1cgo-ecommerce/proto/cart;cartb\x06proto3"
+	"DeleteItem\x12\x17.cart.DeleteItemRequest\x1a\x18.cart.DeleteItemResponse\x12H\n" +
+	"\rGetCartDetail\x12\x1a.cart.GetCartDetailRequest\x1a\x1b.cart.GetCartDetailResponse\x12B\n" +
+	"\vSelectItems\x12\x18.cart.SelectItemsRequest\x1a\x19.cart.SelectItemsResponse\x12W\n" +
+	"\x12UpdateItemQuantity\x12\x1f.cart.UpdateItemQuantityRequest\x1a .cart.UpdateItemQuantityResponse\x12<\n" +
+	"\tMergeCart\x12\x16.cart.MergeCartRequest\x1a\x17.cart.MergeCartResponse\x12A\n" +
+	"\x0eCreateCheckout\x12\x1b.cart.CreateCheckoutRequest\x1a\x12.cart.CheckoutInfo\x12=\n" +
+	"\fLockCheckout\x12\x19.cart.LockCheckoutRequest\x1a\x12.cart.CheckoutInfo\x12;\n" +
+	"\vGetCheckout\x12\x18.cart.GetCheckoutRequest\x1a\x12.cart.CheckoutInfo\x12K\n" +
+	"\x0eUnlockCheckout\x12\x1b.cart.UnlockCheckoutRequest\x1a\x1c.cart.UnlockCheckoutResponseB\x1eZ\x1cgo-ecommerce/proto/cart;cartb\x06proto3"
 
 var (
 	file_proto_cart_cart_proto_rawDescOnce sync.Once
@@ -514,34 +1563,67 @@ func file_proto_cart_cart_proto_rawDescGZIP() []byte {
 	return file_proto_cart_cart_proto_rawDescData
 }
 
-var file_proto_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_cart_cart_proto_goTypes = []any{
-	(*CartItem)(nil),           // 0: cart.CartItem
-	(*AddItemRequest)(nil),     // 1: cart.AddItemRequest
-	(*AddItemResponse)(nil),    // 2: cart.AddItemResponse
-	(*GetCartRequest)(nil),     // 3: cart.GetCartRequest
-	(*GetCartResponse)(nil),    // 4: cart.GetCartResponse
-	(*EmptyCartRequest)(nil),   // 5: cart.EmptyCartRequest
-	(*EmptyCartResponse)(nil),  // 6: cart.EmptyCartResponse
-	(*DeleteItemRequest)(nil),  // 7: cart.DeleteItemRequest
-	(*DeleteItemResponse)(nil), // 8: cart.DeleteItemResponse
+	(*CartItem)(nil),                   // 0: cart.CartItem
+	(*AddItemRequest)(nil),             // 1: cart.AddItemRequest
+	(*AddItemResponse)(nil),            // 2: cart.AddItemResponse
+	(*GetCartRequest)(nil),             // 3: cart.GetCartRequest
+	(*GetCartResponse)(nil),            // 4: cart.GetCartResponse
+	(*EmptyCartRequest)(nil),           // 5: cart.EmptyCartRequest
+	(*EmptyCartResponse)(nil),          // 6: cart.EmptyCartResponse
+	(*DeleteItemRequest)(nil),          // 7: cart.DeleteItemRequest
+	(*DeleteItemResponse)(nil),         // 8: cart.DeleteItemResponse
+	(*CartItemDetail)(nil),             // 9: cart.CartItemDetail
+	(*GetCartDetailRequest)(nil),       // 10: cart.GetCartDetailRequest
+	(*GetCartDetailResponse)(nil),      // 11: cart.GetCartDetailResponse
+	(*SelectItemsRequest)(nil),         // 12: cart.SelectItemsRequest
+	(*SelectItemsResponse)(nil),        // 13: cart.SelectItemsResponse
+	(*UpdateItemQuantityRequest)(nil),  // 14: cart.UpdateItemQuantityRequest
+	(*UpdateItemQuantityResponse)(nil), // 15: cart.UpdateItemQuantityResponse
+	(*MergeCartRequest)(nil),           // 16: cart.MergeCartRequest
+	(*MergeCartResponse)(nil),          // 17: cart.MergeCartResponse
+	(*CreateCheckoutRequest)(nil),      // 18: cart.CreateCheckoutRequest
+	(*CheckoutInfo)(nil),               // 19: cart.CheckoutInfo
+	(*LockCheckoutRequest)(nil),        // 20: cart.LockCheckoutRequest
+	(*UnlockCheckoutRequest)(nil),      // 21: cart.UnlockCheckoutRequest
+	(*UnlockCheckoutResponse)(nil),     // 22: cart.UnlockCheckoutResponse
+	(*GetCheckoutRequest)(nil),         // 23: cart.GetCheckoutRequest
 }
 var file_proto_cart_cart_proto_depIdxs = []int32{
-	0, // 0: cart.AddItemRequest.item:type_name -> cart.CartItem
-	0, // 1: cart.GetCartResponse.items:type_name -> cart.CartItem
-	1, // 2: cart.CartService.AddItem:input_type -> cart.AddItemRequest
-	3, // 3: cart.CartService.GetCart:input_type -> cart.GetCartRequest
-	5, // 4: cart.CartService.EmptyCart:input_type -> cart.EmptyCartRequest
-	7, // 5: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
-	2, // 6: cart.CartService.AddItem:output_type -> cart.AddItemResponse
-	4, // 7: cart.CartService.GetCart:output_type -> cart.GetCartResponse
-	6, // 8: cart.CartService.EmptyCart:output_type -> cart.EmptyCartResponse
-	8, // 9: cart.CartService.DeleteItem:output_type -> cart.DeleteItemResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: cart.AddItemRequest.item:type_name -> cart.CartItem
+	0,  // 1: cart.GetCartResponse.items:type_name -> cart.CartItem
+	9,  // 2: cart.GetCartDetailResponse.items:type_name -> cart.CartItemDetail
+	0,  // 3: cart.CheckoutInfo.items:type_name -> cart.CartItem
+	1,  // 4: cart.CartService.AddItem:input_type -> cart.AddItemRequest
+	3,  // 5: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	5,  // 6: cart.CartService.EmptyCart:input_type -> cart.EmptyCartRequest
+	7,  // 7: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
+	10, // 8: cart.CartService.GetCartDetail:input_type -> cart.GetCartDetailRequest
+	12, // 9: cart.CartService.SelectItems:input_type -> cart.SelectItemsRequest
+	14, // 10: cart.CartService.UpdateItemQuantity:input_type -> cart.UpdateItemQuantityRequest
+	16, // 11: cart.CartService.MergeCart:input_type -> cart.MergeCartRequest
+	18, // 12: cart.CartService.CreateCheckout:input_type -> cart.CreateCheckoutRequest
+	20, // 13: cart.CartService.LockCheckout:input_type -> cart.LockCheckoutRequest
+	23, // 14: cart.CartService.GetCheckout:input_type -> cart.GetCheckoutRequest
+	21, // 15: cart.CartService.UnlockCheckout:input_type -> cart.UnlockCheckoutRequest
+	2,  // 16: cart.CartService.AddItem:output_type -> cart.AddItemResponse
+	4,  // 17: cart.CartService.GetCart:output_type -> cart.GetCartResponse
+	6,  // 18: cart.CartService.EmptyCart:output_type -> cart.EmptyCartResponse
+	8,  // 19: cart.CartService.DeleteItem:output_type -> cart.DeleteItemResponse
+	11, // 20: cart.CartService.GetCartDetail:output_type -> cart.GetCartDetailResponse
+	13, // 21: cart.CartService.SelectItems:output_type -> cart.SelectItemsResponse
+	15, // 22: cart.CartService.UpdateItemQuantity:output_type -> cart.UpdateItemQuantityResponse
+	17, // 23: cart.CartService.MergeCart:output_type -> cart.MergeCartResponse
+	19, // 24: cart.CartService.CreateCheckout:output_type -> cart.CheckoutInfo
+	19, // 25: cart.CartService.LockCheckout:output_type -> cart.CheckoutInfo
+	19, // 26: cart.CartService.GetCheckout:output_type -> cart.CheckoutInfo
+	22, // 27: cart.CartService.UnlockCheckout:output_type -> cart.UnlockCheckoutResponse
+	16, // [16:28] is the sub-list for method output_type
+	4,  // [4:16] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_cart_cart_proto_init() }
@@ -555,7 +1637,7 @@ func file_proto_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_cart_cart_proto_rawDesc), len(file_proto_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_AddItem_FullMethodName    = "/cart.CartService/AddItem"
-	CartService_GetCart_FullMethodName    = "/cart.CartService/GetCart"
-	CartService_EmptyCart_FullMethodName  = "/cart.CartService/EmptyCart"
-	CartService_DeleteItem_FullMethodName = "/cart.CartService/DeleteItem"
+	CartService_AddItem_FullMethodName            = "/cart.CartService/AddItem"
+	CartService_GetCart_FullMethodName            = "/cart.CartService/GetCart"
+	CartService_EmptyCart_FullMethodName          = "/cart.CartService/EmptyCart"
+	CartService_DeleteItem_FullMethodName         = "/cart.CartService/DeleteItem"
+	CartService_GetCartDetail_FullMethodName      = "/cart.CartService/GetCartDetail"
+	CartService_SelectItems_FullMethodName        = "/cart.CartService/SelectItems"
+	CartService_UpdateItemQuantity_FullMethodName = "/cart.CartService/UpdateItemQuantity"
+	CartService_MergeCart_FullMethodName          = "/cart.CartService/MergeCart"
+	CartService_CreateCheckout_FullMethodName     = "/cart.CartService/CreateCheckout"
+	CartService_LockCheckout_FullMethodName       = "/cart.CartService/LockCheckout"
+	CartService_GetCheckout_FullMethodName        = "/cart.CartService/GetCheckout"
+	CartService_UnlockCheckout_FullMethodName     = "/cart.CartService/UnlockCheckout"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -37,6 +45,22 @@ type CartServiceClient interface {
 	EmptyCart(ctx context.Context, in *EmptyCartRequest, opts ...grpc.CallOption) (*EmptyCartResponse, error)
 	// 购物车删除商品
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	// 购物车详情：关联 SKU 当前名称、价格、库存，标记失效商品并计算勾选小计
+	GetCartDetail(ctx context.Context, in *GetCartDetailRequest, opts ...grpc.CallOption) (*GetCartDetailResponse, error)
+	// 勾选 / 取消勾选商品
+	SelectItems(ctx context.Context, in *SelectItemsRequest, opts ...grpc.CallOption) (*SelectItemsResponse, error)
+	// 修改商品数量 (设置为绝对值，0 表示移除)
+	UpdateItemQuantity(ctx context.Context, in *UpdateItemQuantityRequest, opts ...grpc.CallOption) (*UpdateItemQuantityResponse, error)
+	// 登录后把游客购物车合并到用户购物车
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+	// 结算快照：冻结要下单的购物车行 (默认为已勾选的行) 及购物车版本号
+	CreateCheckout(ctx context.Context, in *CreateCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error)
+	// 下单时锁定结算快照，同一快照只能提交一次
+	LockCheckout(ctx context.Context, in *LockCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error)
+	// 查询结算快照 (不锁定，用于订单预览)
+	GetCheckout(ctx context.Context, in *GetCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error)
+	// 下单失败时释放锁定，允许重新提交
+	UnlockCheckout(ctx context.Context, in *UnlockCheckoutRequest, opts ...grpc.CallOption) (*UnlockCheckoutResponse, error)
 }
 
 type cartServiceClient struct {
@@ -87,6 +111,86 @@ func (c *cartServiceClient) DeleteItem(ctx context.Context, in *DeleteItemReques
 	return out, nil
 }
 
+func (c *cartServiceClient) GetCartDetail(ctx context.Context, in *GetCartDetailRequest, opts ...grpc.CallOption) (*GetCartDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartDetailResponse)
+	err := c.cc.Invoke(ctx, CartService_GetCartDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) SelectItems(ctx context.Context, in *SelectItemsRequest, opts ...grpc.CallOption) (*SelectItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectItemsResponse)
+	err := c.cc.Invoke(ctx, CartService_SelectItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) UpdateItemQuantity(ctx context.Context, in *UpdateItemQuantityRequest, opts ...grpc.CallOption) (*UpdateItemQuantityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemQuantityResponse)
+	err := c.cc.Invoke(ctx, CartService_UpdateItemQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCartResponse)
+	err := c.cc.Invoke(ctx, CartService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) CreateCheckout(ctx context.Context, in *CreateCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutInfo)
+	err := c.cc.Invoke(ctx, CartService_CreateCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) LockCheckout(ctx context.Context, in *LockCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutInfo)
+	err := c.cc.Invoke(ctx, CartService_LockCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetCheckout(ctx context.Context, in *GetCheckoutRequest, opts ...grpc.CallOption) (*CheckoutInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutInfo)
+	err := c.cc.Invoke(ctx, CartService_GetCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) UnlockCheckout(ctx context.Context, in *UnlockCheckoutRequest, opts ...grpc.CallOption) (*UnlockCheckoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockCheckoutResponse)
+	err := c.cc.Invoke(ctx, CartService_UnlockCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -99,6 +203,22 @@ type CartServiceServer interface {
 	EmptyCart(context.Context, *EmptyCartRequest) (*EmptyCartResponse, error)
 	// 购物车删除商品
 	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	// 购物车详情：关联 SKU 当前名称、价格、库存，标记失效商品并计算勾选小计
+	GetCartDetail(context.Context, *GetCartDetailRequest) (*GetCartDetailResponse, error)
+	// 勾选 / 取消勾选商品
+	SelectItems(context.Context, *SelectItemsRequest) (*SelectItemsResponse, error)
+	// 修改商品数量 (设置为绝对值，0 表示移除)
+	UpdateItemQuantity(context.Context, *UpdateItemQuantityRequest) (*UpdateItemQuantityResponse, error)
+	// 登录后把游客购物车合并到用户购物车
+	MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error)
+	// 结算快照：冻结要下单的购物车行 (默认为已勾选的行) 及购物车版本号
+	CreateCheckout(context.Context, *CreateCheckoutRequest) (*CheckoutInfo, error)
+	// 下单时锁定结算快照，同一快照只能提交一次
+	LockCheckout(context.Context, *LockCheckoutRequest) (*CheckoutInfo, error)
+	// 查询结算快照 (不锁定，用于订单预览)
+	GetCheckout(context.Context, *GetCheckoutRequest) (*CheckoutInfo, error)
+	// 下单失败时释放锁定，允许重新提交
+	UnlockCheckout(context.Context, *UnlockCheckoutRequest) (*UnlockCheckoutResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -121,6 +241,30 @@ func (UnimplementedCartServiceServer) EmptyCart(context.Context, *EmptyCartReque
 func (UnimplementedCartServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteItem not implemented")
 }
+func (UnimplementedCartServiceServer) GetCartDetail(context.Context, *GetCartDetailRequest) (*GetCartDetailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCartDetail not implemented")
+}
+func (UnimplementedCartServiceServer) SelectItems(context.Context, *SelectItemsRequest) (*SelectItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelectItems not implemented")
+}
+func (UnimplementedCartServiceServer) UpdateItemQuantity(context.Context, *UpdateItemQuantityRequest) (*UpdateItemQuantityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateItemQuantity not implemented")
+}
+func (UnimplementedCartServiceServer) MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCart not implemented")
+}
+func (UnimplementedCartServiceServer) CreateCheckout(context.Context, *CreateCheckoutRequest) (*CheckoutInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCheckout not implemented")
+}
+func (UnimplementedCartServiceServer) LockCheckout(context.Context, *LockCheckoutRequest) (*CheckoutInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method LockCheckout not implemented")
+}
+func (UnimplementedCartServiceServer) GetCheckout(context.Context, *GetCheckoutRequest) (*CheckoutInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCheckout not implemented")
+}
+func (UnimplementedCartServiceServer) UnlockCheckout(context.Context, *UnlockCheckoutRequest) (*UnlockCheckoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockCheckout not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -214,6 +358,150 @@ func _CartService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_GetCartDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCartDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetCartDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCartDetail(ctx, req.(*GetCartDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_SelectItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).SelectItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_SelectItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).SelectItems(ctx, req.(*SelectItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_UpdateItemQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).UpdateItemQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_UpdateItemQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).UpdateItemQuantity(ctx, req.(*UpdateItemQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_CreateCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreateCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreateCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreateCheckout(ctx, req.(*CreateCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_LockCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).LockCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_LockCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).LockCheckout(ctx, req.(*LockCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCheckout(ctx, req.(*GetCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_UnlockCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).UnlockCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_UnlockCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).UnlockCheckout(ctx, req.(*UnlockCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +525,38 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItem",
 			Handler:    _CartService_DeleteItem_Handler,
 		},
+		{
+			MethodName: "GetCartDetail",
+			Handler:    _CartService_GetCartDetail_Handler,
+		},
+		{
+			MethodName: "SelectItems",
+			Handler:    _CartService_SelectItems_Handler,
+		},
+		{
+			MethodName: "UpdateItemQuantity",
+			Handler:    _CartService_UpdateItemQuantity_Handler,
+		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _CartService_MergeCart_Handler,
+		},
+		{
+			MethodName: "CreateCheckout",
+			Handler:    _CartService_CreateCheckout_Handler,
+		},
+		{
+			MethodName: "LockCheckout",
+			Handler:    _CartService_LockCheckout_Handler,
+		},
+		{
+			MethodName: "GetCheckout",
+			Handler:    _CartService_GetCheckout_Handler,
+		},
+		{
+			MethodName: "UnlockCheckout",
+			Handler:    _CartService_UnlockCheckout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/cart/cart.proto",
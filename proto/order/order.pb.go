@@ -25,7 +25,11 @@ type CreateOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	SkuIds        []int64                `protobuf:"varint,3,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,3,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`     // 未提供 checkout_id 时按这些商品即时创建结算快照
+	CheckoutId    string                 `protobuf:"bytes,4,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"` // Cart Service 返回的结算快照
+	Lines         []*OrderLine           `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`                             // 立即购买：直接指定商品与数量，不经过购物车
+	PriceToken    string                 `protobuf:"bytes,6,opt,name=price_token,json=priceToken,proto3" json:"price_token,omitempty"` // PreviewOrder 返回的价格令牌，必填；应付金额必须与确认时一致
+	CouponId      int64                  `protobuf:"varint,7,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`      // 使用的用户优惠券 (UserCouponInfo.id)，0 表示不使用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,28 +85,56 @@ func (x *CreateOrderRequest) GetSkuIds() []int64 {
 	return nil
 }
 
-type CreateOrderResponse struct {
+func (x *CreateOrderRequest) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPriceToken() string {
+	if x != nil {
+		return x.PriceToken
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+type OrderLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	TotalAmount   float32                `protobuf:"fixed32,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
+func (x *OrderLine) Reset() {
+	*x = OrderLine{}
 	mi := &file_proto_order_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *OrderLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*OrderLine) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderLine) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_order_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -114,46 +146,48 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderLine.ProtoReflect.Descriptor instead.
+func (*OrderLine) Descriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateOrderResponse) GetOrderNo() string {
+func (x *OrderLine) GetSkuId() int64 {
 	if x != nil {
-		return x.OrderNo
+		return x.SkuId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderResponse) GetTotalAmount() float32 {
+func (x *OrderLine) GetQuantity() int32 {
 	if x != nil {
-		return x.TotalAmount
+		return x.Quantity
 	}
 	return 0
 }
 
-type ListOrdersRequest struct {
+type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	ShippingFee   int64                  `protobuf:"varint,3,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
 	mi := &file_proto_order_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *CreateOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*CreateOrderResponse) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -165,39 +199,62 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListOrdersRequest) GetUserId() int64 {
+func (x *CreateOrderResponse) GetOrderNo() string {
 	if x != nil {
-		return x.UserId
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *CreateOrderResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
 	}
 	return 0
 }
 
-type ListOrdersResponse struct {
+func (x *CreateOrderResponse) GetShippingFee() int64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+type PreviewLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*OrderInfo           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SkuName       string                 `protobuf:"bytes,4,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"` // 当前价格
+	Quantity      int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Stock         int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
+	Available     bool                   `protobuf:"varint,9,opt,name=available,proto3" json:"available,omitempty"` // 商品有效且库存充足
+	Subtotal      int64                  `protobuf:"varint,10,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
+func (x *PreviewLine) Reset() {
+	*x = PreviewLine{}
 	mi := &file_proto_order_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *PreviewLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*PreviewLine) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *PreviewLine) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -209,46 +266,112 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewLine.ProtoReflect.Descriptor instead.
+func (*PreviewLine) Descriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*OrderInfo {
+func (x *PreviewLine) GetSkuId() int64 {
 	if x != nil {
-		return x.Orders
+		return x.SkuId
 	}
-	return nil
+	return 0
 }
 
-type OrderInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo         string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	TotalAmount     float32                `protobuf:"fixed32,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status          int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	ReceiverName    string                 `protobuf:"bytes,6,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
-	ReceiverMobile  string                 `protobuf:"bytes,7,opt,name=receiver_mobile,json=receiverMobile,proto3" json:"receiver_mobile,omitempty"`
-	ReceiverAddress string                 `protobuf:"bytes,8,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PreviewLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
 }
 
-func (x *OrderInfo) Reset() {
-	*x = OrderInfo{}
+func (x *PreviewLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewLine) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *PreviewLine) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *PreviewLine) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PreviewLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreviewLine) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *PreviewLine) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PreviewLine) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+type PreviewOrderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Lines          []*PreviewLine         `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	GoodsAmount    int64                  `protobuf:"varint,2,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`
+	ShippingFee    int64                  `protobuf:"varint,3,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	PayAmount      int64                  `protobuf:"varint,5,opt,name=pay_amount,json=payAmount,proto3" json:"pay_amount,omitempty"`                   // 应付金额 = 商品金额 + 运费 - 优惠
+	Deliverable    bool                   `protobuf:"varint,6,opt,name=deliverable,proto3" json:"deliverable,omitempty"`                                // 收货地址是否可配送
+	Available      bool                   `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`                                    // 可以下单 (全部商品有效、有货且可配送)
+	Message        string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`                                         // 不可下单的原因
+	PriceToken     string                 `protobuf:"bytes,9,opt,name=price_token,json=priceToken,proto3" json:"price_token,omitempty"`                 // 可以下单时返回，下单时原样传回
+	TokenExpiresAt int64                  `protobuf:"varint,10,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"` // 价格令牌过期时间 (Unix 秒)
+	Discounts      []*OrderDiscountInfo   `protobuf:"bytes,11,rep,name=discounts,proto3" json:"discounts,omitempty"`                                    // 优惠明细
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewOrderResponse) Reset() {
+	*x = PreviewOrderResponse{}
 	mi := &file_proto_order_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderInfo) String() string {
+func (x *PreviewOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderInfo) ProtoMessage() {}
+func (*PreviewOrderResponse) ProtoMessage() {}
 
-func (x *OrderInfo) ProtoReflect() protoreflect.Message {
+func (x *PreviewOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -260,95 +383,113 @@ func (x *OrderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderInfo.ProtoReflect.Descriptor instead.
-func (*OrderInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewOrderResponse.ProtoReflect.Descriptor instead.
+func (*PreviewOrderResponse) Descriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *OrderInfo) GetOrderNo() string {
+func (x *PreviewOrderResponse) GetLines() []*PreviewLine {
 	if x != nil {
-		return x.OrderNo
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderInfo) GetTotalAmount() float32 {
+func (x *PreviewOrderResponse) GetGoodsAmount() int64 {
 	if x != nil {
-		return x.TotalAmount
+		return x.GoodsAmount
 	}
 	return 0
 }
 
-func (x *OrderInfo) GetStatus() int32 {
+func (x *PreviewOrderResponse) GetShippingFee() int64 {
 	if x != nil {
-		return x.Status
+		return x.ShippingFee
 	}
 	return 0
 }
 
-func (x *OrderInfo) GetCreatedAt() string {
+func (x *PreviewOrderResponse) GetDiscountAmount() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.DiscountAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderInfo) GetItems() []*OrderItem {
+func (x *PreviewOrderResponse) GetPayAmount() int64 {
 	if x != nil {
-		return x.Items
+		return x.PayAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderInfo) GetReceiverName() string {
+func (x *PreviewOrderResponse) GetDeliverable() bool {
 	if x != nil {
-		return x.ReceiverName
+		return x.Deliverable
 	}
-	return ""
+	return false
 }
 
-func (x *OrderInfo) GetReceiverMobile() string {
+func (x *PreviewOrderResponse) GetAvailable() bool {
 	if x != nil {
-		return x.ReceiverMobile
+		return x.Available
+	}
+	return false
+}
+
+func (x *PreviewOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *OrderInfo) GetReceiverAddress() string {
+func (x *PreviewOrderResponse) GetPriceToken() string {
 	if x != nil {
-		return x.ReceiverAddress
+		return x.PriceToken
 	}
 	return ""
 }
 
-type OrderItem struct {
+func (x *PreviewOrderResponse) GetTokenExpiresAt() int64 {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return 0
+}
+
+func (x *PreviewOrderResponse) GetDiscounts() []*OrderDiscountInfo {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+// OrderDiscountInfo 订单优惠明细
+type OrderDiscountInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductName   string                 `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	SkuName       string                 `protobuf:"bytes,2,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
-	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
-	SkuId         int64                  `protobuf:"varint,6,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	IsReviewed    bool                   `protobuf:"varint,8,opt,name=is_reviewed,json=isReviewed,proto3" json:"is_reviewed,omitempty"`
+	CouponId      int64                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	UserCouponId  int64                  `protobuf:"varint,2,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
+func (x *OrderDiscountInfo) Reset() {
+	*x = OrderDiscountInfo{}
 	mi := &file_proto_order_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *OrderDiscountInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*OrderDiscountInfo) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
+func (x *OrderDiscountInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,89 +501,1095 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderDiscountInfo.ProtoReflect.Descriptor instead.
+func (*OrderDiscountInfo) Descriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OrderItem) GetProductName() string {
+func (x *OrderDiscountInfo) GetCouponId() int64 {
 	if x != nil {
-		return x.ProductName
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *OrderDiscountInfo) GetUserCouponId() int64 {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return 0
+}
+
+func (x *OrderDiscountInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OrderItem) GetSkuName() string {
+func (x *OrderDiscountInfo) GetAmount() int64 {
 	if x != nil {
-		return x.SkuName
+		return x.Amount
+	}
+	return 0
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*OrderInfo           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*OrderInfo {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type OrderInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo         string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	TotalAmount     int64                  `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Status          int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	ReceiverName    string                 `protobuf:"bytes,6,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	ReceiverMobile  string                 `protobuf:"bytes,7,opt,name=receiver_mobile,json=receiverMobile,proto3" json:"receiver_mobile,omitempty"`
+	ReceiverAddress string                 `protobuf:"bytes,8,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	// 金额明细：total_amount = goods_amount + shipping_fee - discount_amount
+	GoodsAmount    int64                `protobuf:"varint,9,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`
+	ShippingFee    int64                `protobuf:"varint,10,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	DiscountAmount int64                `protobuf:"varint,11,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Discounts      []*OrderDiscountInfo `protobuf:"bytes,12,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderInfo) Reset() {
+	*x = OrderInfo{}
+	mi := &file_proto_order_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderInfo) ProtoMessage() {}
+
+func (x *OrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderInfo.ProtoReflect.Descriptor instead.
+func (*OrderInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrderInfo) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderInfo) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetReceiverMobile() string {
+	if x != nil {
+		return x.ReceiverMobile
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetReceiverAddress() string {
+	if x != nil {
+		return x.ReceiverAddress
+	}
+	return ""
+}
+
+func (x *OrderInfo) GetGoodsAmount() int64 {
+	if x != nil {
+		return x.GoodsAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetShippingFee() int64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetDiscounts() []*OrderDiscountInfo {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type OrderItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductName    string                 `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	SkuName        string                 `protobuf:"bytes,2,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	Price          int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity       int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Picture        string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	SkuId          int64                  `protobuf:"varint,6,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId      int64                  `protobuf:"varint,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IsReviewed     bool                   `protobuf:"varint,8,opt,name=is_reviewed,json=isReviewed,proto3" json:"is_reviewed,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,9,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 分摊到该商品行的优惠
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_proto_order_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetIsReviewed() bool {
+	if x != nil {
+		return x.IsReviewed
+	}
+	return false
+}
+
+func (x *OrderItem) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+type MarkOrderPaidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkOrderPaidRequest) Reset() {
+	*x = MarkOrderPaidRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkOrderPaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkOrderPaidRequest) ProtoMessage() {}
+
+func (x *MarkOrderPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkOrderPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkOrderPaidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkOrderPaidRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+type MarkOrderPaidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkOrderPaidResponse) Reset() {
+	*x = MarkOrderPaidResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkOrderPaidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkOrderPaidResponse) ProtoMessage() {}
+
+func (x *MarkOrderPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkOrderPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkOrderPaidResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkOrderPaidResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelOrderRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateOrderStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateItemReviewStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	IsReviewed    bool                   `protobuf:"varint,3,opt,name=is_reviewed,json=isReviewed,proto3" json:"is_reviewed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemReviewStatusRequest) Reset() {
+	*x = UpdateItemReviewStatusRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemReviewStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemReviewStatusRequest) ProtoMessage() {}
+
+func (x *UpdateItemReviewStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemReviewStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemReviewStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateItemReviewStatusRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *UpdateItemReviewStatusRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *UpdateItemReviewStatusRequest) GetIsReviewed() bool {
+	if x != nil {
+		return x.IsReviewed
+	}
+	return false
+}
+
+type UpdateItemReviewStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemReviewStatusResponse) Reset() {
+	*x = UpdateItemReviewStatusResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemReviewStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemReviewStatusResponse) ProtoMessage() {}
+
+func (x *UpdateItemReviewStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemReviewStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemReviewStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateItemReviewStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AnonymizeUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserOrdersRequest) Reset() {
+	*x = AnonymizeUserOrdersRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserOrdersRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AnonymizeUserOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AnonymizeUserOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anonymized    int64                  `protobuf:"varint,1,opt,name=anonymized,proto3" json:"anonymized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserOrdersResponse) Reset() {
+	*x = AnonymizeUserOrdersResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserOrdersResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnonymizeUserOrdersResponse) GetAnonymized() int64 {
+	if x != nil {
+		return x.Anonymized
+	}
+	return 0
+}
+
+type ShippingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingItem) Reset() {
+	*x = ShippingItem{}
+	mi := &file_proto_order_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingItem) ProtoMessage() {}
+
+func (x *ShippingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingItem.ProtoReflect.Descriptor instead.
+func (*ShippingItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ShippingItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ShippingItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type QuoteShippingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Items         []*ShippingItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteShippingRequest) Reset() {
+	*x = QuoteShippingRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteShippingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingRequest) ProtoMessage() {}
+
+func (x *QuoteShippingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingRequest.ProtoReflect.Descriptor instead.
+func (*QuoteShippingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QuoteShippingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QuoteShippingRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *QuoteShippingRequest) GetItems() []*ShippingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type QuoteShippingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliverable   bool                   `protobuf:"varint,1,opt,name=deliverable,proto3" json:"deliverable,omitempty"` // 该地址是否可配送
+	GoodsAmount   int64                  `protobuf:"varint,2,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`
+	ShippingFee   int64                  `protobuf:"varint,3,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`
+	RuleName      string                 `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`                 // 命中的运费规则
+	FreeThreshold int64                  `protobuf:"varint,5,opt,name=free_threshold,json=freeThreshold,proto3" json:"free_threshold,omitempty"` // 满额包邮门槛，0 表示不包邮
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                                   // 提示文案，如“再买 10.00 元包邮”
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteShippingResponse) Reset() {
+	*x = QuoteShippingResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteShippingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteShippingResponse) ProtoMessage() {}
+
+func (x *QuoteShippingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteShippingResponse.ProtoReflect.Descriptor instead.
+func (*QuoteShippingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuoteShippingResponse) GetDeliverable() bool {
+	if x != nil {
+		return x.Deliverable
 	}
-	return ""
+	return false
 }
 
-func (x *OrderItem) GetPrice() float32 {
+func (x *QuoteShippingResponse) GetGoodsAmount() int64 {
 	if x != nil {
-		return x.Price
+		return x.GoodsAmount
 	}
 	return 0
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *QuoteShippingResponse) GetShippingFee() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.ShippingFee
 	}
 	return 0
 }
 
-func (x *OrderItem) GetPicture() string {
+func (x *QuoteShippingResponse) GetRuleName() string {
 	if x != nil {
-		return x.Picture
+		return x.RuleName
 	}
 	return ""
 }
 
-func (x *OrderItem) GetSkuId() int64 {
+func (x *QuoteShippingResponse) GetFreeThreshold() int64 {
 	if x != nil {
-		return x.SkuId
+		return x.FreeThreshold
 	}
 	return 0
 }
 
-func (x *OrderItem) GetProductId() int64 {
+func (x *QuoteShippingResponse) GetMessage() string {
 	if x != nil {
-		return x.ProductId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderItem) GetIsReviewed() bool {
+type ListProductSalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductSalesRequest) Reset() {
+	*x = ListProductSalesRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductSalesRequest) ProtoMessage() {}
+
+func (x *ListProductSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[23]
 	if x != nil {
-		return x.IsReviewed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type MarkOrderPaidRequest struct {
+// Deprecated: Use ListProductSalesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductSalesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+type ProductSales struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkOrderPaidRequest) Reset() {
-	*x = MarkOrderPaidRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[6]
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_proto_order_order_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkOrderPaidRequest) String() string {
+func (x *ProductSales) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkOrderPaidRequest) ProtoMessage() {}
+func (*ProductSales) ProtoMessage() {}
 
-func (x *MarkOrderPaidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[6]
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,40 +1600,47 @@ func (x *MarkOrderPaidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkOrderPaidRequest.ProtoReflect.Descriptor instead.
-func (*MarkOrderPaidRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MarkOrderPaidRequest) GetOrderNo() string {
+func (x *ProductSales) GetProductId() int64 {
 	if x != nil {
-		return x.OrderNo
+		return x.ProductId
 	}
-	return ""
+	return 0
 }
 
-type MarkOrderPaidResponse struct {
+func (x *ProductSales) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListProductSalesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Sales         []*ProductSales        `protobuf:"bytes,1,rep,name=sales,proto3" json:"sales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkOrderPaidResponse) Reset() {
-	*x = MarkOrderPaidResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[7]
+func (x *ListProductSalesResponse) Reset() {
+	*x = ListProductSalesResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkOrderPaidResponse) String() string {
+func (x *ListProductSalesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkOrderPaidResponse) ProtoMessage() {}
+func (*ListProductSalesResponse) ProtoMessage() {}
 
-func (x *MarkOrderPaidResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[7]
+func (x *ListProductSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,41 +1651,52 @@ func (x *MarkOrderPaidResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkOrderPaidResponse.ProtoReflect.Descriptor instead.
-func (*MarkOrderPaidResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListProductSalesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductSalesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MarkOrderPaidResponse) GetSuccess() bool {
+func (x *ListProductSalesResponse) GetSales() []*ProductSales {
 	if x != nil {
-		return x.Success
+		return x.Sales
 	}
-	return false
-}
-
-type CancelOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[8]
+// 运费规则：regions 为空表示全国默认规则
+type ShippingRuleInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Regions        []string               `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`          // 适用省份编码
+	Deliverable    bool                   `protobuf:"varint,4,opt,name=deliverable,proto3" json:"deliverable,omitempty"` // false 表示该区域不配送
+	Mode           string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`                // count 按件 / weight 按重量 (克)
+	FirstUnit      int32                  `protobuf:"varint,6,opt,name=first_unit,json=firstUnit,proto3" json:"first_unit,omitempty"`
+	FirstFee       int64                  `protobuf:"varint,7,opt,name=first_fee,json=firstFee,proto3" json:"first_fee,omitempty"`
+	AdditionalUnit int32                  `protobuf:"varint,8,opt,name=additional_unit,json=additionalUnit,proto3" json:"additional_unit,omitempty"`
+	AdditionalFee  int64                  `protobuf:"varint,9,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee,omitempty"`
+	FreeThreshold  int64                  `protobuf:"varint,10,opt,name=free_threshold,json=freeThreshold,proto3" json:"free_threshold,omitempty"` // 满额包邮，0 表示不包邮
+	Priority       int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                                // 多条规则命中同一省份时取优先级高的
+	Enabled        bool                   `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShippingRuleInfo) Reset() {
+	*x = ShippingRuleInfo{}
+	mi := &file_proto_order_order_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *ShippingRuleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*ShippingRuleInfo) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[8]
+func (x *ShippingRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,47 +1707,116 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ShippingRuleInfo.ProtoReflect.Descriptor instead.
+func (*ShippingRuleInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CancelOrderRequest) GetOrderNo() string {
+func (x *ShippingRuleInfo) GetId() int64 {
 	if x != nil {
-		return x.OrderNo
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CancelOrderRequest) GetUserId() int64 {
+func (x *ShippingRuleInfo) GetRegions() []string {
 	if x != nil {
-		return x.UserId
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ShippingRuleInfo) GetDeliverable() bool {
+	if x != nil {
+		return x.Deliverable
+	}
+	return false
+}
+
+func (x *ShippingRuleInfo) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ShippingRuleInfo) GetFirstUnit() int32 {
+	if x != nil {
+		return x.FirstUnit
 	}
 	return 0
 }
 
-type CancelOrderResponse struct {
+func (x *ShippingRuleInfo) GetFirstFee() int64 {
+	if x != nil {
+		return x.FirstFee
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetAdditionalUnit() int32 {
+	if x != nil {
+		return x.AdditionalUnit
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetAdditionalFee() int64 {
+	if x != nil {
+		return x.AdditionalFee
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetFreeThreshold() int64 {
+	if x != nil {
+		return x.FreeThreshold
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ShippingRuleInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListShippingRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[9]
+func (x *ListShippingRulesRequest) Reset() {
+	*x = ListShippingRulesRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderResponse) String() string {
+func (x *ListShippingRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResponse) ProtoMessage() {}
+func (*ListShippingRulesRequest) ProtoMessage() {}
 
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[9]
+func (x *ListShippingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,41 +1827,33 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *CancelOrderResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ListShippingRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListShippingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{27}
 }
 
-type UpdateOrderStatusRequest struct {
+type ListShippingRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Rules         []*ShippingRuleInfo    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[10]
+func (x *ListShippingRulesResponse) Reset() {
+	*x = ListShippingRulesResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *ListShippingRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*ListShippingRulesResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[10]
+func (x *ListShippingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,47 +1864,40 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UpdateOrderStatusRequest) GetOrderNo() string {
-	if x != nil {
-		return x.OrderNo
-	}
-	return ""
+// Deprecated: Use ListShippingRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListShippingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() int32 {
+func (x *ListShippingRulesResponse) GetRules() []*ShippingRuleInfo {
 	if x != nil {
-		return x.Status
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-type UpdateOrderStatusResponse struct {
+type SaveShippingRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[11]
+func (x *SaveShippingRuleResponse) Reset() {
+	*x = SaveShippingRuleResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *SaveShippingRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*SaveShippingRuleResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[11]
+func (x *SaveShippingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,42 +1908,40 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SaveShippingRuleResponse.ProtoReflect.Descriptor instead.
+func (*SaveShippingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateOrderStatusResponse) GetSuccess() bool {
+func (x *SaveShippingRuleResponse) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type UpdateItemReviewStatusRequest struct {
+type DeleteShippingRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	IsReviewed    bool                   `protobuf:"varint,3,opt,name=is_reviewed,json=isReviewed,proto3" json:"is_reviewed,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemReviewStatusRequest) Reset() {
-	*x = UpdateItemReviewStatusRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[12]
+func (x *DeleteShippingRuleRequest) Reset() {
+	*x = DeleteShippingRuleRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemReviewStatusRequest) String() string {
+func (x *DeleteShippingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemReviewStatusRequest) ProtoMessage() {}
+func (*DeleteShippingRuleRequest) ProtoMessage() {}
 
-func (x *UpdateItemReviewStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[12]
+func (x *DeleteShippingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,54 +1952,40 @@ func (x *UpdateItemReviewStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemReviewStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateItemReviewStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *UpdateItemReviewStatusRequest) GetOrderNo() string {
-	if x != nil {
-		return x.OrderNo
-	}
-	return ""
+// Deprecated: Use DeleteShippingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateItemReviewStatusRequest) GetSkuId() int64 {
+func (x *DeleteShippingRuleRequest) GetId() int64 {
 	if x != nil {
-		return x.SkuId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateItemReviewStatusRequest) GetIsReviewed() bool {
-	if x != nil {
-		return x.IsReviewed
-	}
-	return false
-}
-
-type UpdateItemReviewStatusResponse struct {
+type DeleteShippingRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemReviewStatusResponse) Reset() {
-	*x = UpdateItemReviewStatusResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[13]
+func (x *DeleteShippingRuleResponse) Reset() {
+	*x = DeleteShippingRuleResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemReviewStatusResponse) String() string {
+func (x *DeleteShippingRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemReviewStatusResponse) ProtoMessage() {}
+func (*DeleteShippingRuleResponse) ProtoMessage() {}
 
-func (x *UpdateItemReviewStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[13]
+func (x *DeleteShippingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,12 +1996,12 @@ func (x *UpdateItemReviewStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemReviewStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateItemReviewStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteShippingRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateItemReviewStatusResponse) GetSuccess() bool {
+func (x *DeleteShippingRuleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
@@ -809,40 +2012,89 @@ var File_proto_order_order_proto protoreflect.FileDescriptor
 
 const file_proto_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x17proto/order/order.proto\x12\x05order\"e\n" +
+	"\x17proto/order/order.proto\x12\x05order\"\xec\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x02 \x01(\x03R\taddressId\x12\x17\n" +
-	"\asku_ids\x18\x03 \x03(\x03R\x06skuIds\"S\n" +
+	"\asku_ids\x18\x03 \x03(\x03R\x06skuIds\x12\x1f\n" +
+	"\vcheckout_id\x18\x04 \x01(\tR\n" +
+	"checkoutId\x12&\n" +
+	"\x05lines\x18\x05 \x03(\v2\x10.order.OrderLineR\x05lines\x12\x1f\n" +
+	"\vprice_token\x18\x06 \x01(\tR\n" +
+	"priceToken\x12\x1b\n" +
+	"\tcoupon_id\x18\a \x01(\x03R\bcouponId\">\n" +
+	"\tOrderLine\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"v\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
 	"\border_no\x18\x01 \x01(\tR\aorderNo\x12!\n" +
-	"\ftotal_amount\x18\x02 \x01(\x02R\vtotalAmount\",\n" +
+	"\ftotal_amount\x18\x02 \x01(\x03R\vtotalAmount\x12!\n" +
+	"\fshipping_fee\x18\x03 \x01(\x03R\vshippingFee\"\x8e\x02\n" +
+	"\vPreviewLine\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05stock\x18\b \x01(\x05R\x05stock\x12\x1c\n" +
+	"\tavailable\x18\t \x01(\bR\tavailable\x12\x1a\n" +
+	"\bsubtotal\x18\n" +
+	" \x01(\x03R\bsubtotal\"\xab\x03\n" +
+	"\x14PreviewOrderResponse\x12(\n" +
+	"\x05lines\x18\x01 \x03(\v2\x12.order.PreviewLineR\x05lines\x12!\n" +
+	"\fgoods_amount\x18\x02 \x01(\x03R\vgoodsAmount\x12!\n" +
+	"\fshipping_fee\x18\x03 \x01(\x03R\vshippingFee\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\x03R\x0ediscountAmount\x12\x1d\n" +
+	"\n" +
+	"pay_amount\x18\x05 \x01(\x03R\tpayAmount\x12 \n" +
+	"\vdeliverable\x18\x06 \x01(\bR\vdeliverable\x12\x1c\n" +
+	"\tavailable\x18\a \x01(\bR\tavailable\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x1f\n" +
+	"\vprice_token\x18\t \x01(\tR\n" +
+	"priceToken\x12(\n" +
+	"\x10token_expires_at\x18\n" +
+	" \x01(\x03R\x0etokenExpiresAt\x126\n" +
+	"\tdiscounts\x18\v \x03(\v2\x18.order.OrderDiscountInfoR\tdiscounts\"\x82\x01\n" +
+	"\x11OrderDiscountInfo\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x03R\bcouponId\x12$\n" +
+	"\x0euser_coupon_id\x18\x02 \x01(\x03R\fuserCouponId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\",\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
 	"\x12ListOrdersResponse\x12(\n" +
-	"\x06orders\x18\x01 \x03(\v2\x10.order.OrderInfoR\x06orders\"\xa1\x02\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.order.OrderInfoR\x06orders\"\xc8\x03\n" +
 	"\tOrderInfo\x12\x19\n" +
 	"\border_no\x18\x01 \x01(\tR\aorderNo\x12!\n" +
-	"\ftotal_amount\x18\x02 \x01(\x02R\vtotalAmount\x12\x16\n" +
+	"\ftotal_amount\x18\x02 \x01(\x03R\vtotalAmount\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12&\n" +
 	"\x05items\x18\x05 \x03(\v2\x10.order.OrderItemR\x05items\x12#\n" +
 	"\rreceiver_name\x18\x06 \x01(\tR\freceiverName\x12'\n" +
 	"\x0freceiver_mobile\x18\a \x01(\tR\x0ereceiverMobile\x12)\n" +
-	"\x10receiver_address\x18\b \x01(\tR\x0freceiverAddress\"\xec\x01\n" +
+	"\x10receiver_address\x18\b \x01(\tR\x0freceiverAddress\x12!\n" +
+	"\fgoods_amount\x18\t \x01(\x03R\vgoodsAmount\x12!\n" +
+	"\fshipping_fee\x18\n" +
+	" \x01(\x03R\vshippingFee\x12'\n" +
+	"\x0fdiscount_amount\x18\v \x01(\x03R\x0ediscountAmount\x126\n" +
+	"\tdiscounts\x18\f \x03(\v2\x18.order.OrderDiscountInfoR\tdiscounts\"\x95\x02\n" +
 	"\tOrderItem\x12!\n" +
 	"\fproduct_name\x18\x01 \x01(\tR\vproductName\x12\x19\n" +
 	"\bsku_name\x18\x02 \x01(\tR\askuName\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1a\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x18\n" +
 	"\apicture\x18\x05 \x01(\tR\apicture\x12\x15\n" +
 	"\x06sku_id\x18\x06 \x01(\x03R\x05skuId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\a \x01(\x03R\tproductId\x12\x1f\n" +
 	"\vis_reviewed\x18\b \x01(\bR\n" +
-	"isReviewed\"1\n" +
+	"isReviewed\x12'\n" +
+	"\x0fdiscount_amount\x18\t \x01(\x03R\x0ediscountAmount\"1\n" +
 	"\x14MarkOrderPaidRequest\x12\x19\n" +
 	"\border_no\x18\x01 \x01(\tR\aorderNo\"1\n" +
 	"\x15MarkOrderPaidResponse\x12\x18\n" +
@@ -863,15 +2115,74 @@ const file_proto_order_order_proto_rawDesc = "" +
 	"\vis_reviewed\x18\x03 \x01(\bR\n" +
 	"isReviewed\":\n" +
 	"\x1eUpdateItemReviewStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xe8\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\x1aAnonymizeUserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"=\n" +
+	"\x1bAnonymizeUserOrdersResponse\x12\x1e\n" +
+	"\n" +
+	"anonymized\x18\x01 \x01(\x03R\n" +
+	"anonymized\"A\n" +
+	"\fShippingItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"y\n" +
+	"\x14QuoteShippingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\x12)\n" +
+	"\x05items\x18\x03 \x03(\v2\x13.order.ShippingItemR\x05items\"\xdd\x01\n" +
+	"\x15QuoteShippingResponse\x12 \n" +
+	"\vdeliverable\x18\x01 \x01(\bR\vdeliverable\x12!\n" +
+	"\fgoods_amount\x18\x02 \x01(\x03R\vgoodsAmount\x12!\n" +
+	"\fshipping_fee\x18\x03 \x01(\x03R\vshippingFee\x12\x1b\n" +
+	"\trule_name\x18\x04 \x01(\tR\bruleName\x12%\n" +
+	"\x0efree_threshold\x18\x05 \x01(\x03R\rfreeThreshold\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\x19\n" +
+	"\x17ListProductSalesRequest\"I\n" +
+	"\fProductSales\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"E\n" +
+	"\x18ListProductSalesResponse\x12)\n" +
+	"\x05sales\x18\x01 \x03(\v2\x13.order.ProductSalesR\x05sales\"\xef\x02\n" +
+	"\x10ShippingRuleInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aregions\x18\x03 \x03(\tR\aregions\x12 \n" +
+	"\vdeliverable\x18\x04 \x01(\bR\vdeliverable\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"first_unit\x18\x06 \x01(\x05R\tfirstUnit\x12\x1b\n" +
+	"\tfirst_fee\x18\a \x01(\x03R\bfirstFee\x12'\n" +
+	"\x0fadditional_unit\x18\b \x01(\x05R\x0eadditionalUnit\x12%\n" +
+	"\x0eadditional_fee\x18\t \x01(\x03R\radditionalFee\x12%\n" +
+	"\x0efree_threshold\x18\n" +
+	" \x01(\x03R\rfreeThreshold\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x18\n" +
+	"\aenabled\x18\f \x01(\bR\aenabled\"\x1a\n" +
+	"\x18ListShippingRulesRequest\"J\n" +
+	"\x19ListShippingRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.order.ShippingRuleInfoR\x05rules\"*\n" +
+	"\x18SaveShippingRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"+\n" +
+	"\x19DeleteShippingRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteShippingRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb0\b\n" +
 	"\fOrderService\x12D\n" +
-	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12A\n" +
+	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12F\n" +
+	"\fPreviewOrder\x12\x19.order.CreateOrderRequest\x1a\x1b.order.PreviewOrderResponse\x12A\n" +
 	"\n" +
 	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12J\n" +
 	"\rMarkOrderPaid\x12\x1b.order.MarkOrderPaidRequest\x1a\x1c.order.MarkOrderPaidResponse\x12D\n" +
 	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12V\n" +
 	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12e\n" +
-	"\x16UpdateItemReviewStatus\x12$.order.UpdateItemReviewStatusRequest\x1a%.order.UpdateItemReviewStatusResponseB\x1aZ\x18go-ecommerce/proto/orderb\x06proto3"
+	"\x16UpdateItemReviewStatus\x12$.order.UpdateItemReviewStatusRequest\x1a%.order.UpdateItemReviewStatusResponse\x12\\\n" +
+	"\x13AnonymizeUserOrders\x12!.order.AnonymizeUserOrdersRequest\x1a\".order.AnonymizeUserOrdersResponse\x12J\n" +
+	"\rQuoteShipping\x12\x1b.order.QuoteShippingRequest\x1a\x1c.order.QuoteShippingResponse\x12S\n" +
+	"\x10ListProductSales\x12\x1e.order.ListProductSalesRequest\x1a\x1f.order.ListProductSalesResponse\x12V\n" +
+	"\x11ListShippingRules\x12\x1f.order.ListShippingRulesRequest\x1a .order.ListShippingRulesResponse\x12L\n" +
+	"\x10SaveShippingRule\x12\x17.order.ShippingRuleInfo\x1a\x1f.order.SaveShippingRuleResponse\x12Y\n" +
+	"\x12DeleteShippingRule\x12 .order.DeleteShippingRuleRequest\x1a!.order.DeleteShippingRuleResponseB\x1aZ\x18go-ecommerce/proto/orderb\x06proto3"
 
 var (
 	file_proto_order_order_proto_rawDescOnce sync.Once
@@ -885,43 +2196,82 @@ func file_proto_order_order_proto_rawDescGZIP() []byte {
 	return file_proto_order_order_proto_rawDescData
 }
 
-var file_proto_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_proto_order_order_proto_goTypes = []any{
 	(*CreateOrderRequest)(nil),             // 0: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),            // 1: order.CreateOrderResponse
-	(*ListOrdersRequest)(nil),              // 2: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),             // 3: order.ListOrdersResponse
-	(*OrderInfo)(nil),                      // 4: order.OrderInfo
-	(*OrderItem)(nil),                      // 5: order.OrderItem
-	(*MarkOrderPaidRequest)(nil),           // 6: order.MarkOrderPaidRequest
-	(*MarkOrderPaidResponse)(nil),          // 7: order.MarkOrderPaidResponse
-	(*CancelOrderRequest)(nil),             // 8: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil),            // 9: order.CancelOrderResponse
-	(*UpdateOrderStatusRequest)(nil),       // 10: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),      // 11: order.UpdateOrderStatusResponse
-	(*UpdateItemReviewStatusRequest)(nil),  // 12: order.UpdateItemReviewStatusRequest
-	(*UpdateItemReviewStatusResponse)(nil), // 13: order.UpdateItemReviewStatusResponse
+	(*OrderLine)(nil),                      // 1: order.OrderLine
+	(*CreateOrderResponse)(nil),            // 2: order.CreateOrderResponse
+	(*PreviewLine)(nil),                    // 3: order.PreviewLine
+	(*PreviewOrderResponse)(nil),           // 4: order.PreviewOrderResponse
+	(*OrderDiscountInfo)(nil),              // 5: order.OrderDiscountInfo
+	(*ListOrdersRequest)(nil),              // 6: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),             // 7: order.ListOrdersResponse
+	(*OrderInfo)(nil),                      // 8: order.OrderInfo
+	(*OrderItem)(nil),                      // 9: order.OrderItem
+	(*MarkOrderPaidRequest)(nil),           // 10: order.MarkOrderPaidRequest
+	(*MarkOrderPaidResponse)(nil),          // 11: order.MarkOrderPaidResponse
+	(*CancelOrderRequest)(nil),             // 12: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),            // 13: order.CancelOrderResponse
+	(*UpdateOrderStatusRequest)(nil),       // 14: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),      // 15: order.UpdateOrderStatusResponse
+	(*UpdateItemReviewStatusRequest)(nil),  // 16: order.UpdateItemReviewStatusRequest
+	(*UpdateItemReviewStatusResponse)(nil), // 17: order.UpdateItemReviewStatusResponse
+	(*AnonymizeUserOrdersRequest)(nil),     // 18: order.AnonymizeUserOrdersRequest
+	(*AnonymizeUserOrdersResponse)(nil),    // 19: order.AnonymizeUserOrdersResponse
+	(*ShippingItem)(nil),                   // 20: order.ShippingItem
+	(*QuoteShippingRequest)(nil),           // 21: order.QuoteShippingRequest
+	(*QuoteShippingResponse)(nil),          // 22: order.QuoteShippingResponse
+	(*ListProductSalesRequest)(nil),        // 23: order.ListProductSalesRequest
+	(*ProductSales)(nil),                   // 24: order.ProductSales
+	(*ListProductSalesResponse)(nil),       // 25: order.ListProductSalesResponse
+	(*ShippingRuleInfo)(nil),               // 26: order.ShippingRuleInfo
+	(*ListShippingRulesRequest)(nil),       // 27: order.ListShippingRulesRequest
+	(*ListShippingRulesResponse)(nil),      // 28: order.ListShippingRulesResponse
+	(*SaveShippingRuleResponse)(nil),       // 29: order.SaveShippingRuleResponse
+	(*DeleteShippingRuleRequest)(nil),      // 30: order.DeleteShippingRuleRequest
+	(*DeleteShippingRuleResponse)(nil),     // 31: order.DeleteShippingRuleResponse
 }
 var file_proto_order_order_proto_depIdxs = []int32{
-	4,  // 0: order.ListOrdersResponse.orders:type_name -> order.OrderInfo
-	5,  // 1: order.OrderInfo.items:type_name -> order.OrderItem
-	0,  // 2: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	2,  // 3: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	6,  // 4: order.OrderService.MarkOrderPaid:input_type -> order.MarkOrderPaidRequest
-	8,  // 5: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	10, // 6: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	12, // 7: order.OrderService.UpdateItemReviewStatus:input_type -> order.UpdateItemReviewStatusRequest
-	1,  // 8: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	3,  // 9: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	7,  // 10: order.OrderService.MarkOrderPaid:output_type -> order.MarkOrderPaidResponse
-	9,  // 11: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
-	11, // 12: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	13, // 13: order.OrderService.UpdateItemReviewStatus:output_type -> order.UpdateItemReviewStatusResponse
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	1,  // 0: order.CreateOrderRequest.lines:type_name -> order.OrderLine
+	3,  // 1: order.PreviewOrderResponse.lines:type_name -> order.PreviewLine
+	5,  // 2: order.PreviewOrderResponse.discounts:type_name -> order.OrderDiscountInfo
+	8,  // 3: order.ListOrdersResponse.orders:type_name -> order.OrderInfo
+	9,  // 4: order.OrderInfo.items:type_name -> order.OrderItem
+	5,  // 5: order.OrderInfo.discounts:type_name -> order.OrderDiscountInfo
+	20, // 6: order.QuoteShippingRequest.items:type_name -> order.ShippingItem
+	24, // 7: order.ListProductSalesResponse.sales:type_name -> order.ProductSales
+	26, // 8: order.ListShippingRulesResponse.rules:type_name -> order.ShippingRuleInfo
+	0,  // 9: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	0,  // 10: order.OrderService.PreviewOrder:input_type -> order.CreateOrderRequest
+	6,  // 11: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	10, // 12: order.OrderService.MarkOrderPaid:input_type -> order.MarkOrderPaidRequest
+	12, // 13: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	14, // 14: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	16, // 15: order.OrderService.UpdateItemReviewStatus:input_type -> order.UpdateItemReviewStatusRequest
+	18, // 16: order.OrderService.AnonymizeUserOrders:input_type -> order.AnonymizeUserOrdersRequest
+	21, // 17: order.OrderService.QuoteShipping:input_type -> order.QuoteShippingRequest
+	23, // 18: order.OrderService.ListProductSales:input_type -> order.ListProductSalesRequest
+	27, // 19: order.OrderService.ListShippingRules:input_type -> order.ListShippingRulesRequest
+	26, // 20: order.OrderService.SaveShippingRule:input_type -> order.ShippingRuleInfo
+	30, // 21: order.OrderService.DeleteShippingRule:input_type -> order.DeleteShippingRuleRequest
+	2,  // 22: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	4,  // 23: order.OrderService.PreviewOrder:output_type -> order.PreviewOrderResponse
+	7,  // 24: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	11, // 25: order.OrderService.MarkOrderPaid:output_type -> order.MarkOrderPaidResponse
+	13, // 26: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	15, // 27: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	17, // 28: order.OrderService.UpdateItemReviewStatus:output_type -> order.UpdateItemReviewStatusResponse
+	19, // 29: order.OrderService.AnonymizeUserOrders:output_type -> order.AnonymizeUserOrdersResponse
+	22, // 30: order.OrderService.QuoteShipping:output_type -> order.QuoteShippingResponse
+	25, // 31: order.OrderService.ListProductSales:output_type -> order.ListProductSalesResponse
+	28, // 32: order.OrderService.ListShippingRules:output_type -> order.ListShippingRulesResponse
+	29, // 33: order.OrderService.SaveShippingRule:output_type -> order.SaveShippingRuleResponse
+	31, // 34: order.OrderService.DeleteShippingRule:output_type -> order.DeleteShippingRuleResponse
+	22, // [22:35] is the sub-list for method output_type
+	9,  // [9:22] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_order_order_proto_init() }
@@ -935,7 +2285,7 @@ func file_proto_order_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_order_proto_rawDesc), len(file_proto_order_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
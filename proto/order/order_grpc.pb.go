@@ -20,11 +20,18 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	OrderService_CreateOrder_FullMethodName            = "/order.OrderService/CreateOrder"
+	OrderService_PreviewOrder_FullMethodName           = "/order.OrderService/PreviewOrder"
 	OrderService_ListOrders_FullMethodName             = "/order.OrderService/ListOrders"
 	OrderService_MarkOrderPaid_FullMethodName          = "/order.OrderService/MarkOrderPaid"
 	OrderService_CancelOrder_FullMethodName            = "/order.OrderService/CancelOrder"
 	OrderService_UpdateOrderStatus_FullMethodName      = "/order.OrderService/UpdateOrderStatus"
 	OrderService_UpdateItemReviewStatus_FullMethodName = "/order.OrderService/UpdateItemReviewStatus"
+	OrderService_AnonymizeUserOrders_FullMethodName    = "/order.OrderService/AnonymizeUserOrders"
+	OrderService_QuoteShipping_FullMethodName          = "/order.OrderService/QuoteShipping"
+	OrderService_ListProductSales_FullMethodName       = "/order.OrderService/ListProductSales"
+	OrderService_ListShippingRules_FullMethodName      = "/order.OrderService/ListShippingRules"
+	OrderService_SaveShippingRule_FullMethodName       = "/order.OrderService/SaveShippingRule"
+	OrderService_DeleteShippingRule_FullMethodName     = "/order.OrderService/DeleteShippingRule"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -32,11 +39,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	// 订单预览 (确认页)：与 CreateOrder 输入相同，只计价不下单，返回供 CreateOrder 校验的价格令牌
+	PreviewOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	MarkOrderPaid(ctx context.Context, in *MarkOrderPaidRequest, opts ...grpc.CallOption) (*MarkOrderPaidResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
 	UpdateItemReviewStatus(ctx context.Context, in *UpdateItemReviewStatusRequest, opts ...grpc.CallOption) (*UpdateItemReviewStatusResponse, error)
+	// 注销账号：取消待支付订单并抹去收货人信息，订单金额与明细保留
+	AnonymizeUserOrders(ctx context.Context, in *AnonymizeUserOrdersRequest, opts ...grpc.CallOption) (*AnonymizeUserOrdersResponse, error)
+	// 运费试算 (购物车 / 结算页)
+	QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error)
+	// 按商品汇总未取消订单的购买数量 (商品服务回填销量)
+	ListProductSales(ctx context.Context, in *ListProductSalesRequest, opts ...grpc.CallOption) (*ListProductSalesResponse, error)
+	// 运费规则维护 (由 AdminService 调用)
+	ListShippingRules(ctx context.Context, in *ListShippingRulesRequest, opts ...grpc.CallOption) (*ListShippingRulesResponse, error)
+	SaveShippingRule(ctx context.Context, in *ShippingRuleInfo, opts ...grpc.CallOption) (*SaveShippingRuleResponse, error)
+	DeleteShippingRule(ctx context.Context, in *DeleteShippingRuleRequest, opts ...grpc.CallOption) (*DeleteShippingRuleResponse, error)
 }
 
 type orderServiceClient struct {
@@ -57,6 +76,16 @@ func (c *orderServiceClient) CreateOrder(ctx context.Context, in *CreateOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) PreviewOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_PreviewOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListOrdersResponse)
@@ -107,16 +136,88 @@ func (c *orderServiceClient) UpdateItemReviewStatus(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *orderServiceClient) AnonymizeUserOrders(ctx context.Context, in *AnonymizeUserOrdersRequest, opts ...grpc.CallOption) (*AnonymizeUserOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_AnonymizeUserOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) QuoteShipping(ctx context.Context, in *QuoteShippingRequest, opts ...grpc.CallOption) (*QuoteShippingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteShippingResponse)
+	err := c.cc.Invoke(ctx, OrderService_QuoteShipping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListProductSales(ctx context.Context, in *ListProductSalesRequest, opts ...grpc.CallOption) (*ListProductSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductSalesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListProductSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListShippingRules(ctx context.Context, in *ListShippingRulesRequest, opts ...grpc.CallOption) (*ListShippingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShippingRulesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListShippingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SaveShippingRule(ctx context.Context, in *ShippingRuleInfo, opts ...grpc.CallOption) (*SaveShippingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveShippingRuleResponse)
+	err := c.cc.Invoke(ctx, OrderService_SaveShippingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeleteShippingRule(ctx context.Context, in *DeleteShippingRuleRequest, opts ...grpc.CallOption) (*DeleteShippingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShippingRuleResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeleteShippingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
 type OrderServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	// 订单预览 (确认页)：与 CreateOrder 输入相同，只计价不下单，返回供 CreateOrder 校验的价格令牌
+	PreviewOrder(context.Context, *CreateOrderRequest) (*PreviewOrderResponse, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	MarkOrderPaid(context.Context, *MarkOrderPaidRequest) (*MarkOrderPaidResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
 	UpdateItemReviewStatus(context.Context, *UpdateItemReviewStatusRequest) (*UpdateItemReviewStatusResponse, error)
+	// 注销账号：取消待支付订单并抹去收货人信息，订单金额与明细保留
+	AnonymizeUserOrders(context.Context, *AnonymizeUserOrdersRequest) (*AnonymizeUserOrdersResponse, error)
+	// 运费试算 (购物车 / 结算页)
+	QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error)
+	// 按商品汇总未取消订单的购买数量 (商品服务回填销量)
+	ListProductSales(context.Context, *ListProductSalesRequest) (*ListProductSalesResponse, error)
+	// 运费规则维护 (由 AdminService 调用)
+	ListShippingRules(context.Context, *ListShippingRulesRequest) (*ListShippingRulesResponse, error)
+	SaveShippingRule(context.Context, *ShippingRuleInfo) (*SaveShippingRuleResponse, error)
+	DeleteShippingRule(context.Context, *DeleteShippingRuleRequest) (*DeleteShippingRuleResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -130,6 +231,9 @@ type UnimplementedOrderServiceServer struct{}
 func (UnimplementedOrderServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) PreviewOrder(context.Context, *CreateOrderRequest) (*PreviewOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListOrders not implemented")
 }
@@ -145,6 +249,24 @@ func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *Updat
 func (UnimplementedOrderServiceServer) UpdateItemReviewStatus(context.Context, *UpdateItemReviewStatusRequest) (*UpdateItemReviewStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateItemReviewStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) AnonymizeUserOrders(context.Context, *AnonymizeUserOrdersRequest) (*AnonymizeUserOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnonymizeUserOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) QuoteShipping(context.Context, *QuoteShippingRequest) (*QuoteShippingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteShipping not implemented")
+}
+func (UnimplementedOrderServiceServer) ListProductSales(context.Context, *ListProductSalesRequest) (*ListProductSalesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductSales not implemented")
+}
+func (UnimplementedOrderServiceServer) ListShippingRules(context.Context, *ListShippingRulesRequest) (*ListShippingRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShippingRules not implemented")
+}
+func (UnimplementedOrderServiceServer) SaveShippingRule(context.Context, *ShippingRuleInfo) (*SaveShippingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveShippingRule not implemented")
+}
+func (UnimplementedOrderServiceServer) DeleteShippingRule(context.Context, *DeleteShippingRuleRequest) (*DeleteShippingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteShippingRule not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -184,6 +306,24 @@ func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_PreviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PreviewOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrdersRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +414,114 @@ func _OrderService_UpdateItemReviewStatus_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_AnonymizeUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AnonymizeUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AnonymizeUserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AnonymizeUserOrders(ctx, req.(*AnonymizeUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_QuoteShipping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteShippingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteShipping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteShipping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteShipping(ctx, req.(*QuoteShippingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListProductSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListProductSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListProductSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListProductSales(ctx, req.(*ListProductSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListShippingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShippingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListShippingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListShippingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListShippingRules(ctx, req.(*ListShippingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SaveShippingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShippingRuleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SaveShippingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SaveShippingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SaveShippingRule(ctx, req.(*ShippingRuleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeleteShippingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShippingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteShippingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteShippingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteShippingRule(ctx, req.(*DeleteShippingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +533,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _OrderService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "PreviewOrder",
+			Handler:    _OrderService_PreviewOrder_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _OrderService_ListOrders_Handler,
@@ -305,6 +557,30 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateItemReviewStatus",
 			Handler:    _OrderService_UpdateItemReviewStatus_Handler,
 		},
+		{
+			MethodName: "AnonymizeUserOrders",
+			Handler:    _OrderService_AnonymizeUserOrders_Handler,
+		},
+		{
+			MethodName: "QuoteShipping",
+			Handler:    _OrderService_QuoteShipping_Handler,
+		},
+		{
+			MethodName: "ListProductSales",
+			Handler:    _OrderService_ListProductSales_Handler,
+		},
+		{
+			MethodName: "ListShippingRules",
+			Handler:    _OrderService_ListShippingRules_Handler,
+		},
+		{
+			MethodName: "SaveShippingRule",
+			Handler:    _OrderService_SaveShippingRule_Handler,
+		},
+		{
+			MethodName: "DeleteShippingRule",
+			Handler:    _OrderService_DeleteShippingRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/order/order.proto",
@@ -24,7 +24,7 @@ const (
 type PayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,7 +66,7 @@ func (x *PayRequest) GetOrderNo() string {
 	return ""
 }
 
-func (x *PayRequest) GetAmount() float32 {
+func (x *PayRequest) GetAmount() int64 {
 	if x != nil {
 		return x.Amount
 	}
@@ -133,7 +133,7 @@ const file_proto_payment_payment_proto_rawDesc = "" +
 	"\n" +
 	"PayRequest\x12\x19\n" +
 	"\border_no\x18\x01 \x01(\tR\aorderNo\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x02R\x06amount\"N\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"N\n" +
 	"\vPayResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId2B\n" +
@@ -22,13 +22,23 @@ const (
 )
 
 type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"` // 搜索关键词
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Page       int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CategoryId int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 包含该分类的所有子分类
+	Query      string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`                              // 搜索关键词
+	MinPrice   int64                  `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`       // 价格下限 (分，含)，0 不限
+	MaxPrice   int64                  `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`       // 价格上限 (分，含)，0 不限
+	InStock    bool                   `protobuf:"varint,7,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`          // 只看有货
+	Sort       string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`                                // price_asc / price_desc / sales / rating，空为默认排序 (有关键词时按相关度)
+	WithFacets bool                   `protobuf:"varint,9,opt,name=with_facets,json=withFacets,proto3" json:"with_facets,omitempty"` // 是否返回分类、价格区间统计
+	UserId     int64                  `protobuf:"varint,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // 已登录用户，有关键词时记录搜索历史
+	// 高亮标记，需同时设置，默认 <em> </em>；HTML 标签只支持 em、strong、b、i、mark、span (可带 class)，
+	// 也可以是不含 HTML 字符的文本 (如【】)；片段中的原文总是做 HTML 转义
+	HighlightPreTag  string `protobuf:"bytes,11,opt,name=highlight_pre_tag,json=highlightPreTag,proto3" json:"highlight_pre_tag,omitempty"`
+	HighlightPostTag string `protobuf:"bytes,12,opt,name=highlight_post_tag,json=highlightPostTag,proto3" json:"highlight_post_tag,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
@@ -89,10 +99,71 @@ func (x *ListProductsRequest) GetQuery() string {
 	return ""
 }
 
+func (x *ListProductsRequest) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetWithFacets() bool {
+	if x != nil {
+		return x.WithFacets
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetHighlightPreTag() string {
+	if x != nil {
+		return x.HighlightPreTag
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetHighlightPostTag() string {
+	if x != nil {
+		return x.HighlightPostTag
+	}
+	return ""
+}
+
 type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total    int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// 分面统计：分类统计不受分类条件影响，价格区间统计不受价格条件影响，便于切换筛选
+	CategoryFacets []*CategoryFacet `protobuf:"bytes,3,rep,name=category_facets,json=categoryFacets,proto3" json:"category_facets,omitempty"`
+	PriceBuckets   []*PriceBucket   `protobuf:"bytes,4,rep,name=price_buckets,json=priceBuckets,proto3" json:"price_buckets,omitempty"`
+	// 搜索服务不可用，关键词结果来自数据库模糊匹配：默认按名称命中优先排序，无高亮片段
+	Degraded      bool `protobuf:"varint,5,opt,name=degraded,proto3" json:"degraded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,35 +212,50 @@ func (x *ListProductsResponse) GetTotal() int64 {
 	return 0
 }
 
-// 定义 Product 消息 (完全保留你的定义)
-type Product struct {
+func (x *ListProductsResponse) GetCategoryFacets() []*CategoryFacet {
+	if x != nil {
+		return x.CategoryFacets
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPriceBuckets() []*PriceBucket {
+	if x != nil {
+		return x.PriceBuckets
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type CategoryFacet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
-	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SkuName       string                 `protobuf:"bytes,7,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
-	SkuId         int64                  `protobuf:"varint,8,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
 	mi := &file_proto_product_product_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *CategoryFacet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*CategoryFacet) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -181,88 +267,55 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Product) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Product) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Product) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Product) GetPicture() string {
-	if x != nil {
-		return x.Picture
-	}
-	return ""
-}
-
-func (x *Product) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *Product) GetCategoryId() int64 {
+func (x *CategoryFacet) GetCategoryId() int64 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *Product) GetSkuName() string {
+func (x *CategoryFacet) GetName() string {
 	if x != nil {
-		return x.SkuName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Product) GetSkuId() int64 {
+func (x *CategoryFacet) GetCount() int64 {
 	if x != nil {
-		return x.SkuId
+		return x.Count
 	}
 	return 0
 }
 
-type GetProductRequest struct {
+type PriceBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // 分，含
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`     // 分，不含；0 表示无上限
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *PriceBucket) Reset() {
+	*x = PriceBucket{}
 	mi := &file_proto_product_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *PriceBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*PriceBucket) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *PriceBucket) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -274,46 +327,67 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceBucket.ProtoReflect.Descriptor instead.
+func (*PriceBucket) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetProductRequest) GetId() int64 {
+func (x *PriceBucket) GetFrom() int64 {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return 0
 }
 
-type GetProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
-	Price         float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SkuName       string                 `protobuf:"bytes,7,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
-	SkuId         int64                  `protobuf:"varint,8,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PriceBucket) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
+func (x *PriceBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 定义 Product 消息 (完全保留你的定义)
+type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Picture     string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price       int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId  int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SkuName     string                 `protobuf:"bytes,7,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	SkuId       int64                  `protobuf:"varint,8,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Sales       int64                  `protobuf:"varint,9,opt,name=sales,proto3" json:"sales,omitempty"`                     // 累计销量
+	Rating      float64                `protobuf:"fixed64,10,opt,name=rating,proto3" json:"rating,omitempty"`                 // 平均评分 (1-5)，暂无评价为 0
+	InStock     bool                   `protobuf:"varint,11,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"` // 是否有在售且有库存的 SKU
+	// 关键词搜索时的高亮片段，name / description 保持原文；无命中时为空
+	NameHighlight        string `protobuf:"bytes,12,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	DescriptionHighlight string `protobuf:"bytes,13,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
 	mi := &file_proto_product_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *Product) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*Product) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+func (x *Product) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,89 +399,125 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetProductResponse) GetId() int64 {
+func (x *Product) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetProductResponse) GetName() string {
+func (x *Product) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetProductResponse) GetDescription() string {
+func (x *Product) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *GetProductResponse) GetPicture() string {
+func (x *Product) GetPicture() string {
 	if x != nil {
 		return x.Picture
 	}
 	return ""
 }
 
-func (x *GetProductResponse) GetPrice() float32 {
+func (x *Product) GetPrice() int64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *GetProductResponse) GetCategoryId() int64 {
+func (x *Product) GetCategoryId() int64 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *GetProductResponse) GetSkuName() string {
+func (x *Product) GetSkuName() string {
 	if x != nil {
 		return x.SkuName
 	}
 	return ""
 }
 
-func (x *GetProductResponse) GetSkuId() int64 {
+func (x *Product) GetSkuId() int64 {
 	if x != nil {
 		return x.SkuId
 	}
 	return 0
 }
 
-type DecreaseStockRequest struct {
+func (x *Product) GetSales() int64 {
+	if x != nil {
+		return x.Sales
+	}
+	return 0
+}
+
+func (x *Product) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Product) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *Product) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *Product) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+type SuggestProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`                // 已输入的内容，为空时只返回热门搜索与历史
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 已登录用户，返回匹配的搜索历史
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                   // 每类最多返回条数，默认 10
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecreaseStockRequest) Reset() {
-	*x = DecreaseStockRequest{}
+func (x *SuggestProductsRequest) Reset() {
+	*x = SuggestProductsRequest{}
 	mi := &file_proto_product_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecreaseStockRequest) String() string {
+func (x *SuggestProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecreaseStockRequest) ProtoMessage() {}
+func (*SuggestProductsRequest) ProtoMessage() {}
 
-func (x *DecreaseStockRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestProductsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,46 +529,56 @@ func (x *DecreaseStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecreaseStockRequest.ProtoReflect.Descriptor instead.
-func (*DecreaseStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestProductsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestProductsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DecreaseStockRequest) GetSkuId() int64 {
+func (x *SuggestProductsRequest) GetPrefix() string {
 	if x != nil {
-		return x.SkuId
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestProductsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *DecreaseStockRequest) GetCount() int32 {
+func (x *SuggestProductsRequest) GetSize() int32 {
 	if x != nil {
-		return x.Count
+		return x.Size
 	}
 	return 0
 }
 
-type DecreaseStockResponse struct {
+type SuggestProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Products      []string               `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`  // 前缀匹配的在售商品名，按销量排序
+	Hot           []string               `protobuf:"bytes,2,rep,name=hot,proto3" json:"hot,omitempty"`            // 近 7 天热门搜索
+	History       []string               `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`    // 该用户的最近搜索
+	Degraded      bool                   `protobuf:"varint,4,opt,name=degraded,proto3" json:"degraded,omitempty"` // 搜索服务不可用，products 为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecreaseStockResponse) Reset() {
-	*x = DecreaseStockResponse{}
+func (x *SuggestProductsResponse) Reset() {
+	*x = SuggestProductsResponse{}
 	mi := &file_proto_product_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecreaseStockResponse) String() string {
+func (x *SuggestProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecreaseStockResponse) ProtoMessage() {}
+func (*SuggestProductsResponse) ProtoMessage() {}
 
-func (x *DecreaseStockResponse) ProtoReflect() protoreflect.Message {
+func (x *SuggestProductsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,40 +590,60 @@ func (x *DecreaseStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecreaseStockResponse.ProtoReflect.Descriptor instead.
-func (*DecreaseStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestProductsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestProductsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DecreaseStockResponse) GetSuccess() bool {
+func (x *SuggestProductsResponse) GetProducts() []string {
 	if x != nil {
-		return x.Success
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SuggestProductsResponse) GetHot() []string {
+	if x != nil {
+		return x.Hot
+	}
+	return nil
+}
+
+func (x *SuggestProductsResponse) GetHistory() []string {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *SuggestProductsResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
 	}
 	return false
 }
 
-type RollbackStockRequest struct {
+type SearchHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackStockRequest) Reset() {
-	*x = RollbackStockRequest{}
+func (x *SearchHistoryRequest) Reset() {
+	*x = SearchHistoryRequest{}
 	mi := &file_proto_product_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackStockRequest) String() string {
+func (x *SearchHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackStockRequest) ProtoMessage() {}
+func (*SearchHistoryRequest) ProtoMessage() {}
 
-func (x *RollbackStockRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,47 +655,2287 @@ func (x *RollbackStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackStockRequest.ProtoReflect.Descriptor instead.
-func (*RollbackStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SearchHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_product_product_proto_rawDescGZIP(), []int{7}
 }
 
+func (x *SearchHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListSearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"` // 最近的在前
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchHistoryResponse) Reset() {
+	*x = ListSearchHistoryResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchHistoryResponse) ProtoMessage() {}
+
+func (x *ListSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSearchHistoryResponse) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type ClearSearchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSearchHistoryResponse) Reset() {
+	*x = ClearSearchHistoryResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSearchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSearchHistoryResponse) ProtoMessage() {}
+
+func (x *ClearSearchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSearchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearSearchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{9}
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 商品 ID (不是 SKU ID，按 SKU 查询请用 GetSku)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"` // 展示价
+	CategoryId    int64                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                              // draft / on_sale / off_shelf / deleted
+	OnShelfAt     int64                  `protobuf:"varint,11,opt,name=on_shelf_at,json=onShelfAt,proto3" json:"on_shelf_at,omitempty"`    // 定时上架时间 (Unix 秒)，0 表示未设置
+	OffShelfAt    int64                  `protobuf:"varint,12,opt,name=off_shelf_at,json=offShelfAt,proto3" json:"off_shelf_at,omitempty"` // 定时下架时间 (Unix 秒)，0 表示未设置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProductResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProductResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetProductResponse) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetProductResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetOnShelfAt() int64 {
+	if x != nil {
+		return x.OnShelfAt
+	}
+	return 0
+}
+
+func (x *GetProductResponse) GetOffShelfAt() int64 {
+	if x != nil {
+		return x.OffShelfAt
+	}
+	return 0
+}
+
+type GetSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuRequest) Reset() {
+	*x = GetSkuRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuRequest) ProtoMessage() {}
+
+func (x *GetSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuRequest.ProtoReflect.Descriptor instead.
+func (*GetSkuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSkuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+// 规格维度，values 按展示顺序排列
+type SpecDimension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []*SpecValue           `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecDimension) Reset() {
+	*x = SpecDimension{}
+	mi := &file_proto_product_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecDimension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecDimension) ProtoMessage() {}
+
+func (x *SpecDimension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecDimension.ProtoReflect.Descriptor instead.
+func (*SpecDimension) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SpecDimension) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecDimension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecDimension) GetValues() []*SpecValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SpecValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecValue) Reset() {
+	*x = SpecValue{}
+	mi := &file_proto_product_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecValue) ProtoMessage() {}
+
+func (x *SpecValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecValue.ProtoReflect.Descriptor instead.
+func (*SpecValue) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SpecValue) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// GetProductDetailResponse 前端据 specs 渲染规格选择器，选中的值组合对应 skus 中 spec_value_ids 相同的 SKU
+type GetProductDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *GetProductResponse    `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Specs         []*SpecDimension       `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"` // 未设置规格维度时为空，按 SKU 名称选择
+	Skus          []*SkuInfo             `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductDetailResponse) Reset() {
+	*x = GetProductDetailResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductDetailResponse) ProtoMessage() {}
+
+func (x *GetProductDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetProductDetailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetProductDetailResponse) GetProduct() *GetProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductDetailResponse) GetSpecs() []*SpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *GetProductDetailResponse) GetSkus() []*SkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// SetProductSpecsRequest 整体覆盖商品的规格维度：id 为 0 的维度 / 值新增，未出现的删除
+// 已有 SKU 时不能增删维度，也不能删除已被 SKU 使用的值
+type SetProductSpecsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Specs         []*SpecDimension       `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductSpecsRequest) Reset() {
+	*x = SetProductSpecsRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductSpecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductSpecsRequest) ProtoMessage() {}
+
+func (x *SetProductSpecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductSpecsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductSpecsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetProductSpecsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductSpecsRequest) GetSpecs() []*SpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+type SetProductSpecsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Specs         []*SpecDimension       `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"` // 保存后的维度 (含新分配的 ID)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductSpecsResponse) Reset() {
+	*x = SetProductSpecsResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductSpecsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductSpecsResponse) ProtoMessage() {}
+
+func (x *SetProductSpecsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductSpecsResponse.ProtoReflect.Descriptor instead.
+func (*SetProductSpecsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetProductSpecsResponse) GetSpecs() []*SpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+type DecreaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecreaseStockRequest) Reset() {
+	*x = DecreaseStockRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecreaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecreaseStockRequest) ProtoMessage() {}
+
+func (x *DecreaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecreaseStockRequest.ProtoReflect.Descriptor instead.
+func (*DecreaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DecreaseStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *DecreaseStockRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type DecreaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecreaseStockResponse) Reset() {
+	*x = DecreaseStockResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecreaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecreaseStockResponse) ProtoMessage() {}
+
+func (x *DecreaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecreaseStockResponse.ProtoReflect.Descriptor instead.
+func (*DecreaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DecreaseStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RollbackStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackStockRequest) Reset() {
+	*x = RollbackStockRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackStockRequest) ProtoMessage() {}
+
+func (x *RollbackStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackStockRequest.ProtoReflect.Descriptor instead.
+func (*RollbackStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{20}
+}
+
 func (x *RollbackStockRequest) GetSkuId() int64 {
 	if x != nil {
-		return x.SkuId
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *RollbackStockRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RollbackStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackStockResponse) Reset() {
+	*x = RollbackStockResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackStockResponse) ProtoMessage() {}
+
+func (x *RollbackStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackStockResponse.ProtoReflect.Descriptor instead.
+func (*RollbackStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RollbackStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SeckillProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeckillProductRequest) Reset() {
+	*x = SeckillProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeckillProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeckillProductRequest) ProtoMessage() {}
+
+func (x *SeckillProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeckillProductRequest.ProtoReflect.Descriptor instead.
+func (*SeckillProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SeckillProductRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SeckillProductRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+type SeckillProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeckillProductResponse) Reset() {
+	*x = SeckillProductResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeckillProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeckillProductResponse) ProtoMessage() {}
+
+func (x *SeckillProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeckillProductResponse.ProtoReflect.Descriptor instead.
+func (*SeckillProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SeckillProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SkuInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 商品名称
+	SkuName       string                 `protobuf:"bytes,4,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"` // SKU 图片，未设置时使用商品主图
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	Weight        int32                  `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"` // 克
+	CategoryId    int64                  `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SpecValueIds  []int64                `protobuf:"varint,10,rep,packed,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"` // 按规格维度顺序，未设置规格维度时为空
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                           // 实际状态：商品不在售时为商品状态，否则为 SKU 状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuInfo) Reset() {
+	*x = SkuInfo{}
+	mi := &file_proto_product_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuInfo) ProtoMessage() {}
+
+func (x *SkuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuInfo.ProtoReflect.Descriptor instead.
+func (*SkuInfo) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SkuInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkuInfo) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuInfo) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *SkuInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SkuInfo) GetSpecValueIds() []int64 {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return nil
+}
+
+func (x *SkuInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type BatchGetSkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []int64                `protobuf:"varint,1,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSkusRequest) Reset() {
+	*x = BatchGetSkusRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSkusRequest) ProtoMessage() {}
+
+func (x *BatchGetSkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSkusRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSkusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchGetSkusRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type BatchGetSkusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []*SkuInfo             `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSkusResponse) Reset() {
+	*x = BatchGetSkusResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSkusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSkusResponse) ProtoMessage() {}
+
+func (x *BatchGetSkusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSkusResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSkusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BatchGetSkusResponse) GetSkus() []*SkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type CategoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 表示一级分类
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`                         // 同级按 sort 升序
+	Children      []*CategoryInfo        `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryInfo) Reset() {
+	*x = CategoryInfo{}
+	mi := &file_proto_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfo) ProtoMessage() {}
+
+func (x *CategoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfo.ProtoReflect.Descriptor instead.
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CategoryInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetChildren() []*CategoryInfo {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        int64                  `protobuf:"varint,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"` // 只返回该分类的子树，0 表示整棵树
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCategoriesRequest) GetRootId() int64 {
+	if x != nil {
+		return x.RootId
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryInfo        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryInfo {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type SaveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCategoryResponse) Reset() {
+	*x = SaveCategoryResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCategoryResponse) ProtoMessage() {}
+
+func (x *SaveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SaveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SaveCategoryResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Picture       string                 `protobuf:"bytes,3,opt,name=picture,proto3" json:"picture,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"` // 展示价
+	CategoryId    int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Skus          []*CreateSkuRequest    `protobuf:"bytes,6,rep,name=skus,proto3" json:"skus,omitempty"`     // 同时创建的 SKU，product_id 无需填写
+	Specs         []*SpecDimension       `protobuf:"bytes,7,rep,name=specs,proto3" json:"specs,omitempty"`   // 同时创建的规格维度，此时 SKU 用 spec_values 按值选择
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // draft / on_sale，为空表示草稿
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSkus() []*CreateSkuRequest {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSpecs() []*SpecDimension {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateProductResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateProductResponse) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+// UpdateProductRequest 只修改提供的字段
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Picture       *string                `protobuf:"bytes,4,opt,name=picture,proto3,oneof" json:"picture,omitempty"`
+	Price         *int64                 `protobuf:"varint,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPicture() string {
+	if x != nil && x.Picture != nil {
+		return *x.Picture
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPrice() int64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 商品设置了规格维度时可为空，按规格值生成
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	Weight        int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`                                          // 克
+	SpecValueIds  []int64                `protobuf:"varint,7,rep,packed,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"` // 每个规格维度选一个值，组合在同一商品内唯一
+	SpecValues    []string               `protobuf:"bytes,8,rep,name=spec_values,json=specValues,proto3" json:"spec_values,omitempty"`                 // 仅 CreateProduct 使用：按维度顺序给出规格值文本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSkuRequest) Reset() {
+	*x = CreateSkuRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSkuRequest) ProtoMessage() {}
+
+func (x *CreateSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSkuRequest.ProtoReflect.Descriptor instead.
+func (*CreateSkuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateSkuRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateSkuRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSkuRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateSkuRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateSkuRequest) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *CreateSkuRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CreateSkuRequest) GetSpecValueIds() []int64 {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return nil
+}
+
+func (x *CreateSkuRequest) GetSpecValues() []string {
+	if x != nil {
+		return x.SpecValues
+	}
+	return nil
+}
+
+type CreateSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSkuResponse) Reset() {
+	*x = CreateSkuResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSkuResponse) ProtoMessage() {}
+
+func (x *CreateSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSkuResponse.ProtoReflect.Descriptor instead.
+func (*CreateSkuResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateSkuResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateSkuRequest 只修改提供的字段；库存使用 SetSkuStock，避免覆盖下单扣减
+type UpdateSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Price         *int64                 `protobuf:"varint,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Picture       *string                `protobuf:"bytes,4,opt,name=picture,proto3,oneof" json:"picture,omitempty"`
+	Weight        *int32                 `protobuf:"varint,5,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	SpecValueIds  []int64                `protobuf:"varint,6,rep,packed,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"` // 为空表示不修改
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSkuRequest) Reset() {
+	*x = UpdateSkuRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSkuRequest) ProtoMessage() {}
+
+func (x *UpdateSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSkuRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSkuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateSkuRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSkuRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSkuRequest) GetPrice() int64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateSkuRequest) GetPicture() string {
+	if x != nil && x.Picture != nil {
+		return *x.Picture
+	}
+	return ""
+}
+
+func (x *UpdateSkuRequest) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateSkuRequest) GetSpecValueIds() []int64 {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return nil
+}
+
+type UpdateSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSkuResponse) Reset() {
+	*x = UpdateSkuResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSkuResponse) ProtoMessage() {}
+
+func (x *UpdateSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSkuResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSkuResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateSkuResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetSkuStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Stock         int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStockRequest) Reset() {
+	*x = SetSkuStockRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStockRequest) ProtoMessage() {}
+
+func (x *SetSkuStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStockRequest.ProtoReflect.Descriptor instead.
+func (*SetSkuStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetSkuStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetSkuStockRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type SetSkuStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuStockResponse) Reset() {
+	*x = SetSkuStockResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuStockResponse) ProtoMessage() {}
+
+func (x *SetSkuStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuStockResponse.ProtoReflect.Descriptor instead.
+func (*SetSkuStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetSkuStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BatchUpdatePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 包含子分类
+	RatioBp       int32                  `protobuf:"varint,2,opt,name=ratio_bp,json=ratioBp,proto3" json:"ratio_bp,omitempty"`          // 万分比：9000 表示打九折
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePriceRequest) Reset() {
+	*x = BatchUpdatePriceRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePriceRequest) ProtoMessage() {}
+
+func (x *BatchUpdatePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePriceRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchUpdatePriceRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *RollbackStockRequest) GetCount() int32 {
+func (x *BatchUpdatePriceRequest) GetRatioBp() int32 {
 	if x != nil {
-		return x.Count
+		return x.RatioBp
 	}
 	return 0
 }
 
-type RollbackStockResponse struct {
+type BatchUpdatePriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int64                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"` // 调整的商品数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePriceResponse) Reset() {
+	*x = BatchUpdatePriceResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePriceResponse) ProtoMessage() {}
+
+func (x *BatchUpdatePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePriceResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchUpdatePriceResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// SetProductStatusRequest 定时时间每次整体覆盖，0 表示取消
+type SetProductStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                              // draft / on_sale / off_shelf，为空表示不修改
+	OnShelfAt     int64                  `protobuf:"varint,3,opt,name=on_shelf_at,json=onShelfAt,proto3" json:"on_shelf_at,omitempty"`    // 定时上架 (Unix 秒)
+	OffShelfAt    int64                  `protobuf:"varint,4,opt,name=off_shelf_at,json=offShelfAt,proto3" json:"off_shelf_at,omitempty"` // 定时下架 (Unix 秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductStatusRequest) Reset() {
+	*x = SetProductStatusRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductStatusRequest) ProtoMessage() {}
+
+func (x *SetProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetProductStatusRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetProductStatusRequest) GetOnShelfAt() int64 {
+	if x != nil {
+		return x.OnShelfAt
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetOffShelfAt() int64 {
+	if x != nil {
+		return x.OffShelfAt
+	}
+	return 0
+}
+
+type SetProductStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollbackStockResponse) Reset() {
-	*x = RollbackStockResponse{}
-	mi := &file_proto_product_product_proto_msgTypes[8]
+func (x *SetProductStatusResponse) Reset() {
+	*x = SetProductStatusResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollbackStockResponse) String() string {
+func (x *SetProductStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollbackStockResponse) ProtoMessage() {}
+func (*SetProductStatusResponse) ProtoMessage() {}
 
-func (x *RollbackStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[8]
+func (x *SetProductStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,41 +2946,41 @@ func (x *RollbackStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollbackStockResponse.ProtoReflect.Descriptor instead.
-func (*RollbackStockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetProductStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetProductStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RollbackStockResponse) GetSuccess() bool {
+func (x *SetProductStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type SeckillProductRequest struct {
+type SetSkuStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // on_sale / off_shelf
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeckillProductRequest) Reset() {
-	*x = SeckillProductRequest{}
-	mi := &file_proto_product_product_proto_msgTypes[9]
+func (x *SetSkuStatusRequest) Reset() {
+	*x = SetSkuStatusRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeckillProductRequest) String() string {
+func (x *SetSkuStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeckillProductRequest) ProtoMessage() {}
+func (*SetSkuStatusRequest) ProtoMessage() {}
 
-func (x *SeckillProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[9]
+func (x *SetSkuStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,47 +2991,47 @@ func (x *SeckillProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeckillProductRequest.ProtoReflect.Descriptor instead.
-func (*SeckillProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SetSkuStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetSkuStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SeckillProductRequest) GetUserId() int64 {
+func (x *SetSkuStatusRequest) GetSkuId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.SkuId
 	}
 	return 0
 }
 
-func (x *SeckillProductRequest) GetSkuId() int64 {
+func (x *SetSkuStatusRequest) GetStatus() string {
 	if x != nil {
-		return x.SkuId
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type SeckillProductResponse struct {
+type SetSkuStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeckillProductResponse) Reset() {
-	*x = SeckillProductResponse{}
-	mi := &file_proto_product_product_proto_msgTypes[10]
+func (x *SetSkuStatusResponse) Reset() {
+	*x = SetSkuStatusResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeckillProductResponse) String() string {
+func (x *SetSkuStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeckillProductResponse) ProtoMessage() {}
+func (*SetSkuStatusResponse) ProtoMessage() {}
 
-func (x *SeckillProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_proto_msgTypes[10]
+func (x *SetSkuStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,54 +3042,287 @@ func (x *SeckillProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeckillProductResponse.ProtoReflect.Descriptor instead.
-func (*SeckillProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SetSkuStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetSkuStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SeckillProductResponse) GetSuccess() bool {
+func (x *SetSkuStatusResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
+type ReindexProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProductsRequest) Reset() {
+	*x = ReindexProductsRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProductsRequest) ProtoMessage() {}
+
+func (x *ReindexProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProductsRequest.ProtoReflect.Descriptor instead.
+func (*ReindexProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{51}
+}
+
+type ReindexProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       bool                   `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"` // 已开始后台重建
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProductsResponse) Reset() {
+	*x = ReindexProductsResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProductsResponse) ProtoMessage() {}
+
+func (x *ReindexProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProductsResponse.ProtoReflect.Descriptor instead.
+func (*ReindexProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReindexProductsResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+type RecordRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Star          int32                  `protobuf:"varint,2,opt,name=star,proto3" json:"star,omitempty"` // 1-5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRatingRequest) Reset() {
+	*x = RecordRatingRequest{}
+	mi := &file_proto_product_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRatingRequest) ProtoMessage() {}
+
+func (x *RecordRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRatingRequest.ProtoReflect.Descriptor instead.
+func (*RecordRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecordRatingRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RecordRatingRequest) GetStar() int32 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+type RecordRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRatingResponse) Reset() {
+	*x = RecordRatingResponse{}
+	mi := &file_proto_product_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRatingResponse) ProtoMessage() {}
+
+func (x *RecordRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRatingResponse.ProtoReflect.Descriptor instead.
+func (*RecordRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_proto_rawDescGZIP(), []int{54}
+}
+
 var File_proto_product_product_proto protoreflect.FileDescriptor
 
 const file_proto_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/product/product.proto\x12\aproduct\"}\n" +
+	"\x1bproto/product/product.proto\x12\aproduct\"\xfa\x02\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x03R\n" +
 	"categoryId\x12\x14\n" +
-	"\x05query\x18\x04 \x01(\tR\x05query\"Z\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\x03R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x06 \x01(\x03R\bmaxPrice\x12\x19\n" +
+	"\bin_stock\x18\a \x01(\bR\ainStock\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sort\x12\x1f\n" +
+	"\vwith_facets\x18\t \x01(\bR\n" +
+	"withFacets\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\x03R\x06userId\x12*\n" +
+	"\x11highlight_pre_tag\x18\v \x01(\tR\x0fhighlightPreTag\x12,\n" +
+	"\x12highlight_post_tag\x18\f \x01(\tR\x10highlightPostTag\"\xf2\x01\n" +
 	"\x14ListProductsResponse\x12,\n" +
 	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xd2\x01\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12?\n" +
+	"\x0fcategory_facets\x18\x03 \x03(\v2\x16.product.CategoryFacetR\x0ecategoryFacets\x129\n" +
+	"\rprice_buckets\x18\x04 \x03(\v2\x14.product.PriceBucketR\fpriceBuckets\x12\x1a\n" +
+	"\bdegraded\x18\x05 \x01(\bR\bdegraded\"Z\n" +
+	"\rCategoryFacet\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"G\n" +
+	"\vPriceBucket\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xf7\x02\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
 	"\apicture\x18\x04 \x01(\tR\apicture\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1f\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\x03R\n" +
 	"categoryId\x12\x19\n" +
 	"\bsku_name\x18\a \x01(\tR\askuName\x12\x15\n" +
-	"\x06sku_id\x18\b \x01(\x03R\x05skuId\"#\n" +
+	"\x06sku_id\x18\b \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05sales\x18\t \x01(\x03R\x05sales\x12\x16\n" +
+	"\x06rating\x18\n" +
+	" \x01(\x01R\x06rating\x12\x19\n" +
+	"\bin_stock\x18\v \x01(\bR\ainStock\x12%\n" +
+	"\x0ename_highlight\x18\f \x01(\tR\rnameHighlight\x123\n" +
+	"\x15description_highlight\x18\r \x01(\tR\x14descriptionHighlight\"]\n" +
+	"\x16SuggestProductsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"}\n" +
+	"\x17SuggestProductsResponse\x12\x1a\n" +
+	"\bproducts\x18\x01 \x03(\tR\bproducts\x12\x10\n" +
+	"\x03hot\x18\x02 \x03(\tR\x03hot\x12\x18\n" +
+	"\ahistory\x18\x03 \x03(\tR\ahistory\x12\x1a\n" +
+	"\bdegraded\x18\x04 \x01(\bR\bdegraded\"/\n" +
+	"\x14SearchHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"5\n" +
+	"\x19ListSearchHistoryResponse\x12\x18\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\"\x1c\n" +
+	"\x1aClearSearchHistoryResponse\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xdd\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x97\x02\n" +
 	"\x12GetProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
 	"\apicture\x18\x04 \x01(\tR\apicture\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1f\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\x03R\n" +
-	"categoryId\x12\x19\n" +
-	"\bsku_name\x18\a \x01(\tR\askuName\x12\x15\n" +
-	"\x06sku_id\x18\b \x01(\x03R\x05skuId\"C\n" +
+	"categoryId\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1e\n" +
+	"\von_shelf_at\x18\v \x01(\x03R\tonShelfAt\x12 \n" +
+	"\foff_shelf_at\x18\f \x01(\x03R\n" +
+	"offShelfAtJ\x04\b\a\x10\bJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"\"&\n" +
+	"\rGetSkuRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\"_\n" +
+	"\rSpecDimension\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x06values\x18\x03 \x03(\v2\x12.product.SpecValueR\x06values\"1\n" +
+	"\tSpecValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xa5\x01\n" +
+	"\x18GetProductDetailResponse\x125\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1b.product.GetProductResponseR\aproduct\x12,\n" +
+	"\x05specs\x18\x02 \x03(\v2\x16.product.SpecDimensionR\x05specs\x12$\n" +
+	"\x04skus\x18\x03 \x03(\v2\x10.product.SkuInfoR\x04skus\"e\n" +
+	"\x16SetProductSpecsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12,\n" +
+	"\x05specs\x18\x02 \x03(\v2\x16.product.SpecDimensionR\x05specs\"G\n" +
+	"\x17SetProductSpecsResponse\x12,\n" +
+	"\x05specs\x18\x01 \x03(\v2\x16.product.SpecDimensionR\x05specs\"C\n" +
 	"\x14DecreaseStockRequest\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"1\n" +
@@ -724,14 +3337,165 @@ const file_proto_product_product_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
 	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\"2\n" +
 	"\x16SeckillProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x97\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xab\x02\n" +
+	"\aSkuInfo\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x05R\x05stock\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x05R\x06weight\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\x03R\n" +
+	"categoryId\x12$\n" +
+	"\x0espec_value_ids\x18\n" +
+	" \x03(\x03R\fspecValueIds\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\".\n" +
+	"\x13BatchGetSkusRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\x03R\x06skuIds\"<\n" +
+	"\x14BatchGetSkusResponse\x12$\n" +
+	"\x04skus\x18\x01 \x03(\v2\x10.product.SkuInfoR\x04skus\"\x96\x01\n" +
+	"\fCategoryInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x121\n" +
+	"\bchildren\x18\x05 \x03(\v2\x15.product.CategoryInfoR\bchildren\"0\n" +
+	"\x15ListCategoriesRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\x03R\x06rootId\"O\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.product.CategoryInfoR\n" +
+	"categories\"&\n" +
+	"\x14SaveCategoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x02\n" +
+	"\x14CreateProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\apicture\x18\x03 \x01(\tR\apicture\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x12-\n" +
+	"\x04skus\x18\x06 \x03(\v2\x19.product.CreateSkuRequestR\x04skus\x12,\n" +
+	"\x05specs\x18\a \x03(\v2\x16.product.SpecDimensionR\x05specs\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"@\n" +
+	"\x15CreateProductResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\x03R\x06skuIds\"\x85\x02\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\apicture\x18\x04 \x01(\tH\x02R\apicture\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x03H\x03R\x05price\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x03H\x04R\n" +
+	"categoryId\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_pictureB\b\n" +
+	"\x06_priceB\x0e\n" +
+	"\f_category_id\"1\n" +
+	"\x15UpdateProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x15DeleteProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xea\x01\n" +
+	"\x10CreateSkuRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\x12$\n" +
+	"\x0espec_value_ids\x18\a \x03(\x03R\fspecValueIds\x12\x1f\n" +
+	"\vspec_values\x18\b \x03(\tR\n" +
+	"specValues\"#\n" +
+	"\x11CreateSkuResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xe2\x01\n" +
+	"\x10UpdateSkuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x03H\x01R\x05price\x88\x01\x01\x12\x1d\n" +
+	"\apicture\x18\x04 \x01(\tH\x02R\apicture\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x05 \x01(\x05H\x03R\x06weight\x88\x01\x01\x12$\n" +
+	"\x0espec_value_ids\x18\x06 \x03(\x03R\fspecValueIdsB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_priceB\n" +
+	"\n" +
+	"\b_pictureB\t\n" +
+	"\a_weight\"-\n" +
+	"\x11UpdateSkuResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x12SetSkuStockRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\"/\n" +
+	"\x13SetSkuStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x17BatchUpdatePriceRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bratio_bp\x18\x02 \x01(\x05R\aratioBp\"4\n" +
+	"\x18BatchUpdatePriceResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\"\x92\x01\n" +
+	"\x17SetProductStatusRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1e\n" +
+	"\von_shelf_at\x18\x03 \x01(\x03R\tonShelfAt\x12 \n" +
+	"\foff_shelf_at\x18\x04 \x01(\x03R\n" +
+	"offShelfAt\"4\n" +
+	"\x18SetProductStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x13SetSkuStatusRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"0\n" +
+	"\x14SetSkuStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\x16ReindexProductsRequest\"?\n" +
+	"\x17ReindexProductsResponse\x12\x18\n" +
+	"\astarted\x18\x03 \x01(\bR\astartedJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"H\n" +
+	"\x13RecordRatingRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04star\x18\x02 \x01(\x05R\x04star\"\x16\n" +
+	"\x14RecordRatingResponse2\x99\x10\n" +
 	"\x0eProductService\x12K\n" +
-	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12E\n" +
+	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12T\n" +
+	"\x0fSuggestProducts\x12\x1f.product.SuggestProductsRequest\x1a .product.SuggestProductsResponse\x12V\n" +
+	"\x11ListSearchHistory\x12\x1d.product.SearchHistoryRequest\x1a\".product.ListSearchHistoryResponse\x12X\n" +
+	"\x12ClearSearchHistory\x12\x1d.product.SearchHistoryRequest\x1a#.product.ClearSearchHistoryResponse\x12E\n" +
 	"\n" +
-	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12N\n" +
+	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12Q\n" +
+	"\x10GetProductDetail\x12\x1a.product.GetProductRequest\x1a!.product.GetProductDetailResponse\x122\n" +
+	"\x06GetSku\x12\x16.product.GetSkuRequest\x1a\x10.product.SkuInfo\x12N\n" +
 	"\rDecreaseStock\x12\x1d.product.DecreaseStockRequest\x1a\x1e.product.DecreaseStockResponse\x12N\n" +
 	"\rRollbackStock\x12\x1d.product.RollbackStockRequest\x1a\x1e.product.RollbackStockResponse\x12Q\n" +
-	"\x0eSeckillProduct\x12\x1e.product.SeckillProductRequest\x1a\x1f.product.SeckillProductResponseB\x1cZ\x1ago-ecommerce/proto/productb\x06proto3"
+	"\x0eSeckillProduct\x12\x1e.product.SeckillProductRequest\x1a\x1f.product.SeckillProductResponse\x12K\n" +
+	"\fBatchGetSkus\x12\x1c.product.BatchGetSkusRequest\x1a\x1d.product.BatchGetSkusResponse\x12Q\n" +
+	"\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12D\n" +
+	"\fSaveCategory\x12\x15.product.CategoryInfo\x1a\x1d.product.SaveCategoryResponse\x12Q\n" +
+	"\x0eDeleteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x1f.product.DeleteCategoryResponse\x12N\n" +
+	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12N\n" +
+	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12N\n" +
+	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12B\n" +
+	"\tCreateSku\x12\x19.product.CreateSkuRequest\x1a\x1a.product.CreateSkuResponse\x12B\n" +
+	"\tUpdateSku\x12\x19.product.UpdateSkuRequest\x1a\x1a.product.UpdateSkuResponse\x12H\n" +
+	"\vSetSkuStock\x12\x1b.product.SetSkuStockRequest\x1a\x1c.product.SetSkuStockResponse\x12T\n" +
+	"\x0fSetProductSpecs\x12\x1f.product.SetProductSpecsRequest\x1a .product.SetProductSpecsResponse\x12W\n" +
+	"\x10SetProductStatus\x12 .product.SetProductStatusRequest\x1a!.product.SetProductStatusResponse\x12K\n" +
+	"\fSetSkuStatus\x12\x1c.product.SetSkuStatusRequest\x1a\x1d.product.SetSkuStatusResponse\x12W\n" +
+	"\x10BatchUpdatePrice\x12 .product.BatchUpdatePriceRequest\x1a!.product.BatchUpdatePriceResponse\x12T\n" +
+	"\x0fReindexProducts\x12\x1f.product.ReindexProductsRequest\x1a .product.ReindexProductsResponse\x12K\n" +
+	"\fRecordRating\x12\x1c.product.RecordRatingRequest\x1a\x1d.product.RecordRatingResponseB\x1cZ\x1ago-ecommerce/proto/productb\x06proto3"
 
 var (
 	file_proto_product_product_proto_rawDescOnce sync.Once
@@ -745,37 +3509,136 @@ func file_proto_product_product_proto_rawDescGZIP() []byte {
 	return file_proto_product_product_proto_rawDescData
 }
 
-var file_proto_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_proto_product_product_proto_goTypes = []any{
-	(*ListProductsRequest)(nil),    // 0: product.ListProductsRequest
-	(*ListProductsResponse)(nil),   // 1: product.ListProductsResponse
-	(*Product)(nil),                // 2: product.Product
-	(*GetProductRequest)(nil),      // 3: product.GetProductRequest
-	(*GetProductResponse)(nil),     // 4: product.GetProductResponse
-	(*DecreaseStockRequest)(nil),   // 5: product.DecreaseStockRequest
-	(*DecreaseStockResponse)(nil),  // 6: product.DecreaseStockResponse
-	(*RollbackStockRequest)(nil),   // 7: product.RollbackStockRequest
-	(*RollbackStockResponse)(nil),  // 8: product.RollbackStockResponse
-	(*SeckillProductRequest)(nil),  // 9: product.SeckillProductRequest
-	(*SeckillProductResponse)(nil), // 10: product.SeckillProductResponse
+	(*ListProductsRequest)(nil),        // 0: product.ListProductsRequest
+	(*ListProductsResponse)(nil),       // 1: product.ListProductsResponse
+	(*CategoryFacet)(nil),              // 2: product.CategoryFacet
+	(*PriceBucket)(nil),                // 3: product.PriceBucket
+	(*Product)(nil),                    // 4: product.Product
+	(*SuggestProductsRequest)(nil),     // 5: product.SuggestProductsRequest
+	(*SuggestProductsResponse)(nil),    // 6: product.SuggestProductsResponse
+	(*SearchHistoryRequest)(nil),       // 7: product.SearchHistoryRequest
+	(*ListSearchHistoryResponse)(nil),  // 8: product.ListSearchHistoryResponse
+	(*ClearSearchHistoryResponse)(nil), // 9: product.ClearSearchHistoryResponse
+	(*GetProductRequest)(nil),          // 10: product.GetProductRequest
+	(*GetProductResponse)(nil),         // 11: product.GetProductResponse
+	(*GetSkuRequest)(nil),              // 12: product.GetSkuRequest
+	(*SpecDimension)(nil),              // 13: product.SpecDimension
+	(*SpecValue)(nil),                  // 14: product.SpecValue
+	(*GetProductDetailResponse)(nil),   // 15: product.GetProductDetailResponse
+	(*SetProductSpecsRequest)(nil),     // 16: product.SetProductSpecsRequest
+	(*SetProductSpecsResponse)(nil),    // 17: product.SetProductSpecsResponse
+	(*DecreaseStockRequest)(nil),       // 18: product.DecreaseStockRequest
+	(*DecreaseStockResponse)(nil),      // 19: product.DecreaseStockResponse
+	(*RollbackStockRequest)(nil),       // 20: product.RollbackStockRequest
+	(*RollbackStockResponse)(nil),      // 21: product.RollbackStockResponse
+	(*SeckillProductRequest)(nil),      // 22: product.SeckillProductRequest
+	(*SeckillProductResponse)(nil),     // 23: product.SeckillProductResponse
+	(*SkuInfo)(nil),                    // 24: product.SkuInfo
+	(*BatchGetSkusRequest)(nil),        // 25: product.BatchGetSkusRequest
+	(*BatchGetSkusResponse)(nil),       // 26: product.BatchGetSkusResponse
+	(*CategoryInfo)(nil),               // 27: product.CategoryInfo
+	(*ListCategoriesRequest)(nil),      // 28: product.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),     // 29: product.ListCategoriesResponse
+	(*SaveCategoryResponse)(nil),       // 30: product.SaveCategoryResponse
+	(*DeleteCategoryRequest)(nil),      // 31: product.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),     // 32: product.DeleteCategoryResponse
+	(*CreateProductRequest)(nil),       // 33: product.CreateProductRequest
+	(*CreateProductResponse)(nil),      // 34: product.CreateProductResponse
+	(*UpdateProductRequest)(nil),       // 35: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),      // 36: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),       // 37: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),      // 38: product.DeleteProductResponse
+	(*CreateSkuRequest)(nil),           // 39: product.CreateSkuRequest
+	(*CreateSkuResponse)(nil),          // 40: product.CreateSkuResponse
+	(*UpdateSkuRequest)(nil),           // 41: product.UpdateSkuRequest
+	(*UpdateSkuResponse)(nil),          // 42: product.UpdateSkuResponse
+	(*SetSkuStockRequest)(nil),         // 43: product.SetSkuStockRequest
+	(*SetSkuStockResponse)(nil),        // 44: product.SetSkuStockResponse
+	(*BatchUpdatePriceRequest)(nil),    // 45: product.BatchUpdatePriceRequest
+	(*BatchUpdatePriceResponse)(nil),   // 46: product.BatchUpdatePriceResponse
+	(*SetProductStatusRequest)(nil),    // 47: product.SetProductStatusRequest
+	(*SetProductStatusResponse)(nil),   // 48: product.SetProductStatusResponse
+	(*SetSkuStatusRequest)(nil),        // 49: product.SetSkuStatusRequest
+	(*SetSkuStatusResponse)(nil),       // 50: product.SetSkuStatusResponse
+	(*ReindexProductsRequest)(nil),     // 51: product.ReindexProductsRequest
+	(*ReindexProductsResponse)(nil),    // 52: product.ReindexProductsResponse
+	(*RecordRatingRequest)(nil),        // 53: product.RecordRatingRequest
+	(*RecordRatingResponse)(nil),       // 54: product.RecordRatingResponse
 }
 var file_proto_product_product_proto_depIdxs = []int32{
-	2,  // 0: product.ListProductsResponse.products:type_name -> product.Product
-	0,  // 1: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
-	3,  // 2: product.ProductService.GetProduct:input_type -> product.GetProductRequest
-	5,  // 3: product.ProductService.DecreaseStock:input_type -> product.DecreaseStockRequest
-	7,  // 4: product.ProductService.RollbackStock:input_type -> product.RollbackStockRequest
-	9,  // 5: product.ProductService.SeckillProduct:input_type -> product.SeckillProductRequest
-	1,  // 6: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
-	4,  // 7: product.ProductService.GetProduct:output_type -> product.GetProductResponse
-	6,  // 8: product.ProductService.DecreaseStock:output_type -> product.DecreaseStockResponse
-	8,  // 9: product.ProductService.RollbackStock:output_type -> product.RollbackStockResponse
-	10, // 10: product.ProductService.SeckillProduct:output_type -> product.SeckillProductResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	4,  // 0: product.ListProductsResponse.products:type_name -> product.Product
+	2,  // 1: product.ListProductsResponse.category_facets:type_name -> product.CategoryFacet
+	3,  // 2: product.ListProductsResponse.price_buckets:type_name -> product.PriceBucket
+	14, // 3: product.SpecDimension.values:type_name -> product.SpecValue
+	11, // 4: product.GetProductDetailResponse.product:type_name -> product.GetProductResponse
+	13, // 5: product.GetProductDetailResponse.specs:type_name -> product.SpecDimension
+	24, // 6: product.GetProductDetailResponse.skus:type_name -> product.SkuInfo
+	13, // 7: product.SetProductSpecsRequest.specs:type_name -> product.SpecDimension
+	13, // 8: product.SetProductSpecsResponse.specs:type_name -> product.SpecDimension
+	24, // 9: product.BatchGetSkusResponse.skus:type_name -> product.SkuInfo
+	27, // 10: product.CategoryInfo.children:type_name -> product.CategoryInfo
+	27, // 11: product.ListCategoriesResponse.categories:type_name -> product.CategoryInfo
+	39, // 12: product.CreateProductRequest.skus:type_name -> product.CreateSkuRequest
+	13, // 13: product.CreateProductRequest.specs:type_name -> product.SpecDimension
+	0,  // 14: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
+	5,  // 15: product.ProductService.SuggestProducts:input_type -> product.SuggestProductsRequest
+	7,  // 16: product.ProductService.ListSearchHistory:input_type -> product.SearchHistoryRequest
+	7,  // 17: product.ProductService.ClearSearchHistory:input_type -> product.SearchHistoryRequest
+	10, // 18: product.ProductService.GetProduct:input_type -> product.GetProductRequest
+	10, // 19: product.ProductService.GetProductDetail:input_type -> product.GetProductRequest
+	12, // 20: product.ProductService.GetSku:input_type -> product.GetSkuRequest
+	18, // 21: product.ProductService.DecreaseStock:input_type -> product.DecreaseStockRequest
+	20, // 22: product.ProductService.RollbackStock:input_type -> product.RollbackStockRequest
+	22, // 23: product.ProductService.SeckillProduct:input_type -> product.SeckillProductRequest
+	25, // 24: product.ProductService.BatchGetSkus:input_type -> product.BatchGetSkusRequest
+	28, // 25: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
+	27, // 26: product.ProductService.SaveCategory:input_type -> product.CategoryInfo
+	31, // 27: product.ProductService.DeleteCategory:input_type -> product.DeleteCategoryRequest
+	33, // 28: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	35, // 29: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	37, // 30: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	39, // 31: product.ProductService.CreateSku:input_type -> product.CreateSkuRequest
+	41, // 32: product.ProductService.UpdateSku:input_type -> product.UpdateSkuRequest
+	43, // 33: product.ProductService.SetSkuStock:input_type -> product.SetSkuStockRequest
+	16, // 34: product.ProductService.SetProductSpecs:input_type -> product.SetProductSpecsRequest
+	47, // 35: product.ProductService.SetProductStatus:input_type -> product.SetProductStatusRequest
+	49, // 36: product.ProductService.SetSkuStatus:input_type -> product.SetSkuStatusRequest
+	45, // 37: product.ProductService.BatchUpdatePrice:input_type -> product.BatchUpdatePriceRequest
+	51, // 38: product.ProductService.ReindexProducts:input_type -> product.ReindexProductsRequest
+	53, // 39: product.ProductService.RecordRating:input_type -> product.RecordRatingRequest
+	1,  // 40: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
+	6,  // 41: product.ProductService.SuggestProducts:output_type -> product.SuggestProductsResponse
+	8,  // 42: product.ProductService.ListSearchHistory:output_type -> product.ListSearchHistoryResponse
+	9,  // 43: product.ProductService.ClearSearchHistory:output_type -> product.ClearSearchHistoryResponse
+	11, // 44: product.ProductService.GetProduct:output_type -> product.GetProductResponse
+	15, // 45: product.ProductService.GetProductDetail:output_type -> product.GetProductDetailResponse
+	24, // 46: product.ProductService.GetSku:output_type -> product.SkuInfo
+	19, // 47: product.ProductService.DecreaseStock:output_type -> product.DecreaseStockResponse
+	21, // 48: product.ProductService.RollbackStock:output_type -> product.RollbackStockResponse
+	23, // 49: product.ProductService.SeckillProduct:output_type -> product.SeckillProductResponse
+	26, // 50: product.ProductService.BatchGetSkus:output_type -> product.BatchGetSkusResponse
+	29, // 51: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
+	30, // 52: product.ProductService.SaveCategory:output_type -> product.SaveCategoryResponse
+	32, // 53: product.ProductService.DeleteCategory:output_type -> product.DeleteCategoryResponse
+	34, // 54: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	36, // 55: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	38, // 56: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	40, // 57: product.ProductService.CreateSku:output_type -> product.CreateSkuResponse
+	42, // 58: product.ProductService.UpdateSku:output_type -> product.UpdateSkuResponse
+	44, // 59: product.ProductService.SetSkuStock:output_type -> product.SetSkuStockResponse
+	17, // 60: product.ProductService.SetProductSpecs:output_type -> product.SetProductSpecsResponse
+	48, // 61: product.ProductService.SetProductStatus:output_type -> product.SetProductStatusResponse
+	50, // 62: product.ProductService.SetSkuStatus:output_type -> product.SetSkuStatusResponse
+	46, // 63: product.ProductService.BatchUpdatePrice:output_type -> product.BatchUpdatePriceResponse
+	52, // 64: product.ProductService.ReindexProducts:output_type -> product.ReindexProductsResponse
+	54, // 65: product.ProductService.RecordRating:output_type -> product.RecordRatingResponse
+	40, // [40:66] is the sub-list for method output_type
+	14, // [14:40] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_product_proto_init() }
@@ -783,13 +3646,15 @@ func file_proto_product_product_proto_init() {
 	if File_proto_product_product_proto != nil {
 		return
 	}
+	file_proto_product_product_proto_msgTypes[35].OneofWrappers = []any{}
+	file_proto_product_product_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_product_proto_rawDesc), len(file_proto_product_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_ListProducts_FullMethodName   = "/product.ProductService/ListProducts"
-	ProductService_GetProduct_FullMethodName     = "/product.ProductService/GetProduct"
-	ProductService_DecreaseStock_FullMethodName  = "/product.ProductService/DecreaseStock"
-	ProductService_RollbackStock_FullMethodName  = "/product.ProductService/RollbackStock"
-	ProductService_SeckillProduct_FullMethodName = "/product.ProductService/SeckillProduct"
+	ProductService_ListProducts_FullMethodName       = "/product.ProductService/ListProducts"
+	ProductService_SuggestProducts_FullMethodName    = "/product.ProductService/SuggestProducts"
+	ProductService_ListSearchHistory_FullMethodName  = "/product.ProductService/ListSearchHistory"
+	ProductService_ClearSearchHistory_FullMethodName = "/product.ProductService/ClearSearchHistory"
+	ProductService_GetProduct_FullMethodName         = "/product.ProductService/GetProduct"
+	ProductService_GetProductDetail_FullMethodName   = "/product.ProductService/GetProductDetail"
+	ProductService_GetSku_FullMethodName             = "/product.ProductService/GetSku"
+	ProductService_DecreaseStock_FullMethodName      = "/product.ProductService/DecreaseStock"
+	ProductService_RollbackStock_FullMethodName      = "/product.ProductService/RollbackStock"
+	ProductService_SeckillProduct_FullMethodName     = "/product.ProductService/SeckillProduct"
+	ProductService_BatchGetSkus_FullMethodName       = "/product.ProductService/BatchGetSkus"
+	ProductService_ListCategories_FullMethodName     = "/product.ProductService/ListCategories"
+	ProductService_SaveCategory_FullMethodName       = "/product.ProductService/SaveCategory"
+	ProductService_DeleteCategory_FullMethodName     = "/product.ProductService/DeleteCategory"
+	ProductService_CreateProduct_FullMethodName      = "/product.ProductService/CreateProduct"
+	ProductService_UpdateProduct_FullMethodName      = "/product.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName      = "/product.ProductService/DeleteProduct"
+	ProductService_CreateSku_FullMethodName          = "/product.ProductService/CreateSku"
+	ProductService_UpdateSku_FullMethodName          = "/product.ProductService/UpdateSku"
+	ProductService_SetSkuStock_FullMethodName        = "/product.ProductService/SetSkuStock"
+	ProductService_SetProductSpecs_FullMethodName    = "/product.ProductService/SetProductSpecs"
+	ProductService_SetProductStatus_FullMethodName   = "/product.ProductService/SetProductStatus"
+	ProductService_SetSkuStatus_FullMethodName       = "/product.ProductService/SetSkuStatus"
+	ProductService_BatchUpdatePrice_FullMethodName   = "/product.ProductService/BatchUpdatePrice"
+	ProductService_ReindexProducts_FullMethodName    = "/product.ProductService/ReindexProducts"
+	ProductService_RecordRating_FullMethodName       = "/product.ProductService/RecordRating"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -31,10 +52,39 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProductServiceClient interface {
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error)
+	ListSearchHistory(ctx context.Context, in *SearchHistoryRequest, opts ...grpc.CallOption) (*ListSearchHistoryResponse, error)
+	ClearSearchHistory(ctx context.Context, in *SearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	GetProductDetail(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductDetailResponse, error)
+	GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*SkuInfo, error)
 	DecreaseStock(ctx context.Context, in *DecreaseStockRequest, opts ...grpc.CallOption) (*DecreaseStockResponse, error)
 	RollbackStock(ctx context.Context, in *RollbackStockRequest, opts ...grpc.CallOption) (*RollbackStockResponse, error)
 	SeckillProduct(ctx context.Context, in *SeckillProductRequest, opts ...grpc.CallOption) (*SeckillProductResponse, error)
+	// 批量查询 SKU 当前信息 (购物车详情、下单)，不存在或不在售的 SKU 不返回
+	BatchGetSkus(ctx context.Context, in *BatchGetSkusRequest, opts ...grpc.CallOption) (*BatchGetSkusResponse, error)
+	// 分类树：ListCategories 供前台导航，其余由 AdminService 调用维护
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	SaveCategory(ctx context.Context, in *CategoryInfo, opts ...grpc.CallOption) (*SaveCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// 商品与 SKU 维护 (AdminService 调用，其他服务不得直接写 db_product)
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	CreateSku(ctx context.Context, in *CreateSkuRequest, opts ...grpc.CallOption) (*CreateSkuResponse, error)
+	UpdateSku(ctx context.Context, in *UpdateSkuRequest, opts ...grpc.CallOption) (*UpdateSkuResponse, error)
+	SetSkuStock(ctx context.Context, in *SetSkuStockRequest, opts ...grpc.CallOption) (*SetSkuStockResponse, error)
+	// 设置商品的规格维度与可选值 (整体覆盖)
+	SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*SetProductSpecsResponse, error)
+	// 上下架：商品状态与定时上下架时间；SKU 单独上下架
+	SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error)
+	SetSkuStatus(ctx context.Context, in *SetSkuStatusRequest, opts ...grpc.CallOption) (*SetSkuStatusResponse, error)
+	// 按比例批量调价 (商品展示价与 SKU 价格)，四舍五入到分
+	BatchUpdatePrice(ctx context.Context, in *BatchUpdatePriceRequest, opts ...grpc.CallOption) (*BatchUpdatePriceResponse, error)
+	// 全量重建搜索索引：后台写入新索引后切换别名，重建期间搜索不中断；立即返回 (AdminService 调用)
+	ReindexProducts(ctx context.Context, in *ReindexProductsRequest, opts ...grpc.CallOption) (*ReindexProductsResponse, error)
+	// 评价服务在新增评价后调用，累计商品评分
+	RecordRating(ctx context.Context, in *RecordRatingRequest, opts ...grpc.CallOption) (*RecordRatingResponse, error)
 }
 
 type productServiceClient struct {
@@ -55,6 +105,36 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SuggestProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSearchHistory(ctx context.Context, in *SearchHistoryRequest, opts ...grpc.CallOption) (*ListSearchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSearchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ClearSearchHistory(ctx context.Context, in *SearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSearchHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ClearSearchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProductResponse)
@@ -65,6 +145,26 @@ func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductReq
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductDetail(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductDetailResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*SkuInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuInfo)
+	err := c.cc.Invoke(ctx, ProductService_GetSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) DecreaseStock(ctx context.Context, in *DecreaseStockRequest, opts ...grpc.CallOption) (*DecreaseStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DecreaseStockResponse)
@@ -95,15 +195,204 @@ func (c *productServiceClient) SeckillProduct(ctx context.Context, in *SeckillPr
 	return out, nil
 }
 
+func (c *productServiceClient) BatchGetSkus(ctx context.Context, in *BatchGetSkusRequest, opts ...grpc.CallOption) (*BatchGetSkusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetSkusResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchGetSkus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveCategory(ctx context.Context, in *CategoryInfo, opts ...grpc.CallOption) (*SaveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_SaveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateSku(ctx context.Context, in *CreateSkuRequest, opts ...grpc.CallOption) (*CreateSkuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSkuResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateSku(ctx context.Context, in *UpdateSkuRequest, opts ...grpc.CallOption) (*UpdateSkuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSkuResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetSkuStock(ctx context.Context, in *SetSkuStockRequest, opts ...grpc.CallOption) (*SetSkuStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSkuStockResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetSkuStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductSpecs(ctx context.Context, in *SetProductSpecsRequest, opts ...grpc.CallOption) (*SetProductSpecsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductSpecsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductSpecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductStatusResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetSkuStatus(ctx context.Context, in *SetSkuStatusRequest, opts ...grpc.CallOption) (*SetSkuStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSkuStatusResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetSkuStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchUpdatePrice(ctx context.Context, in *BatchUpdatePriceRequest, opts ...grpc.CallOption) (*BatchUpdatePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdatePriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchUpdatePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReindexProducts(ctx context.Context, in *ReindexProductsRequest, opts ...grpc.CallOption) (*ReindexProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReindexProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReindexProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecordRating(ctx context.Context, in *RecordRatingRequest, opts ...grpc.CallOption) (*RecordRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordRatingResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecordRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
 type ProductServiceServer interface {
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error)
+	ListSearchHistory(context.Context, *SearchHistoryRequest) (*ListSearchHistoryResponse, error)
+	ClearSearchHistory(context.Context, *SearchHistoryRequest) (*ClearSearchHistoryResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
+	GetProductDetail(context.Context, *GetProductRequest) (*GetProductDetailResponse, error)
+	GetSku(context.Context, *GetSkuRequest) (*SkuInfo, error)
 	DecreaseStock(context.Context, *DecreaseStockRequest) (*DecreaseStockResponse, error)
 	RollbackStock(context.Context, *RollbackStockRequest) (*RollbackStockResponse, error)
 	SeckillProduct(context.Context, *SeckillProductRequest) (*SeckillProductResponse, error)
+	// 批量查询 SKU 当前信息 (购物车详情、下单)，不存在或不在售的 SKU 不返回
+	BatchGetSkus(context.Context, *BatchGetSkusRequest) (*BatchGetSkusResponse, error)
+	// 分类树：ListCategories 供前台导航，其余由 AdminService 调用维护
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	SaveCategory(context.Context, *CategoryInfo) (*SaveCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// 商品与 SKU 维护 (AdminService 调用，其他服务不得直接写 db_product)
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	CreateSku(context.Context, *CreateSkuRequest) (*CreateSkuResponse, error)
+	UpdateSku(context.Context, *UpdateSkuRequest) (*UpdateSkuResponse, error)
+	SetSkuStock(context.Context, *SetSkuStockRequest) (*SetSkuStockResponse, error)
+	// 设置商品的规格维度与可选值 (整体覆盖)
+	SetProductSpecs(context.Context, *SetProductSpecsRequest) (*SetProductSpecsResponse, error)
+	// 上下架：商品状态与定时上下架时间；SKU 单独上下架
+	SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error)
+	SetSkuStatus(context.Context, *SetSkuStatusRequest) (*SetSkuStatusResponse, error)
+	// 按比例批量调价 (商品展示价与 SKU 价格)，四舍五入到分
+	BatchUpdatePrice(context.Context, *BatchUpdatePriceRequest) (*BatchUpdatePriceResponse, error)
+	// 全量重建搜索索引：后台写入新索引后切换别名，重建期间搜索不中断；立即返回 (AdminService 调用)
+	ReindexProducts(context.Context, *ReindexProductsRequest) (*ReindexProductsResponse, error)
+	// 评价服务在新增评价后调用，累计商品评分
+	RecordRating(context.Context, *RecordRatingRequest) (*RecordRatingResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -117,9 +406,24 @@ type UnimplementedProductServiceServer struct{}
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListSearchHistory(context.Context, *SearchHistoryRequest) (*ListSearchHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSearchHistory not implemented")
+}
+func (UnimplementedProductServiceServer) ClearSearchHistory(context.Context, *SearchHistoryRequest) (*ClearSearchHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearSearchHistory not implemented")
+}
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductDetail(context.Context, *GetProductRequest) (*GetProductDetailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductDetail not implemented")
+}
+func (UnimplementedProductServiceServer) GetSku(context.Context, *GetSkuRequest) (*SkuInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSku not implemented")
+}
 func (UnimplementedProductServiceServer) DecreaseStock(context.Context, *DecreaseStockRequest) (*DecreaseStockResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DecreaseStock not implemented")
 }
@@ -129,6 +433,54 @@ func (UnimplementedProductServiceServer) RollbackStock(context.Context, *Rollbac
 func (UnimplementedProductServiceServer) SeckillProduct(context.Context, *SeckillProductRequest) (*SeckillProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SeckillProduct not implemented")
 }
+func (UnimplementedProductServiceServer) BatchGetSkus(context.Context, *BatchGetSkusRequest) (*BatchGetSkusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetSkus not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) SaveCategory(context.Context, *CategoryInfo) (*SaveCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) CreateSku(context.Context, *CreateSkuRequest) (*CreateSkuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSku not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateSku(context.Context, *UpdateSkuRequest) (*UpdateSkuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSku not implemented")
+}
+func (UnimplementedProductServiceServer) SetSkuStock(context.Context, *SetSkuStockRequest) (*SetSkuStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSkuStock not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductSpecs(context.Context, *SetProductSpecsRequest) (*SetProductSpecsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductSpecs not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductStatus not implemented")
+}
+func (UnimplementedProductServiceServer) SetSkuStatus(context.Context, *SetSkuStatusRequest) (*SetSkuStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSkuStatus not implemented")
+}
+func (UnimplementedProductServiceServer) BatchUpdatePrice(context.Context, *BatchUpdatePriceRequest) (*BatchUpdatePriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdatePrice not implemented")
+}
+func (UnimplementedProductServiceServer) ReindexProducts(context.Context, *ReindexProductsRequest) (*ReindexProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReindexProducts not implemented")
+}
+func (UnimplementedProductServiceServer) RecordRating(context.Context, *RecordRatingRequest) (*RecordRatingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordRating not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -168,6 +520,60 @@ func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SuggestProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SuggestProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, req.(*SuggestProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSearchHistory(ctx, req.(*SearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ClearSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ClearSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ClearSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ClearSearchHistory(ctx, req.(*SearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -186,6 +592,42 @@ func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetProductDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductDetail(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetSku(ctx, req.(*GetSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_DecreaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DecreaseStockRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +682,294 @@ func _ProductService_SeckillProduct_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_BatchGetSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchGetSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchGetSkus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchGetSkus(ctx, req.(*BatchGetSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SaveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SaveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SaveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SaveCategory(ctx, req.(*CategoryInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateSku(ctx, req.(*CreateSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateSku(ctx, req.(*UpdateSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetSkuStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkuStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSkuStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetSkuStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSkuStock(ctx, req.(*SetSkuStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductSpecs(ctx, req.(*SetProductSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductStatus(ctx, req.(*SetProductStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetSkuStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkuStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSkuStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetSkuStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSkuStatus(ctx, req.(*SetSkuStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchUpdatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchUpdatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchUpdatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchUpdatePrice(ctx, req.(*BatchUpdatePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReindexProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReindexProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReindexProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReindexProducts(ctx, req.(*ReindexProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RecordRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RecordRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RecordRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RecordRating(ctx, req.(*RecordRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,10 +981,30 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "SuggestProducts",
+			Handler:    _ProductService_SuggestProducts_Handler,
+		},
+		{
+			MethodName: "ListSearchHistory",
+			Handler:    _ProductService_ListSearchHistory_Handler,
+		},
+		{
+			MethodName: "ClearSearchHistory",
+			Handler:    _ProductService_ClearSearchHistory_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProductDetail",
+			Handler:    _ProductService_GetProductDetail_Handler,
+		},
+		{
+			MethodName: "GetSku",
+			Handler:    _ProductService_GetSku_Handler,
+		},
 		{
 			MethodName: "DecreaseStock",
 			Handler:    _ProductService_DecreaseStock_Handler,
@@ -267,6 +1017,70 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SeckillProduct",
 			Handler:    _ProductService_SeckillProduct_Handler,
 		},
+		{
+			MethodName: "BatchGetSkus",
+			Handler:    _ProductService_BatchGetSkus_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ProductService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SaveCategory",
+			Handler:    _ProductService_SaveCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ProductService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "CreateSku",
+			Handler:    _ProductService_CreateSku_Handler,
+		},
+		{
+			MethodName: "UpdateSku",
+			Handler:    _ProductService_UpdateSku_Handler,
+		},
+		{
+			MethodName: "SetSkuStock",
+			Handler:    _ProductService_SetSkuStock_Handler,
+		},
+		{
+			MethodName: "SetProductSpecs",
+			Handler:    _ProductService_SetProductSpecs_Handler,
+		},
+		{
+			MethodName: "SetProductStatus",
+			Handler:    _ProductService_SetProductStatus_Handler,
+		},
+		{
+			MethodName: "SetSkuStatus",
+			Handler:    _ProductService_SetSkuStatus_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePrice",
+			Handler:    _ProductService_BatchUpdatePrice_Handler,
+		},
+		{
+			MethodName: "ReindexProducts",
+			Handler:    _ProductService_ReindexProducts_Handler,
+		},
+		{
+			MethodName: "RecordRating",
+			Handler:    _ProductService_RecordRating_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product/product.proto",
@@ -0,0 +1,1171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/promotion/promotion.proto
+
+package promotion
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CouponInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                   // fixed: 满减 / percent: 折扣
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                              // fixed: 减免金额
+	Percent        int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`                            // percent: 折扣百分比，85 表示 85 折
+	MaxDiscount    int64                  `protobuf:"varint,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // percent: 最多优惠金额，0 表示不限
+	Threshold      int64                  `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛 (适用商品金额满 X 分)，0 表示无门槛
+	Scope          string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`                                 // all: 全场 / category: 指定分类 / sku: 指定商品
+	ScopeIds       []int64                `protobuf:"varint,9,rep,packed,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	TotalQuantity  int32                  `protobuf:"varint,10,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // 发行总量，0 表示不限
+	IssuedQuantity int32                  `protobuf:"varint,11,opt,name=issued_quantity,json=issuedQuantity,proto3" json:"issued_quantity,omitempty"`
+	ClaimLimit     int32                  `protobuf:"varint,12,opt,name=claim_limit,json=claimLimit,proto3" json:"claim_limit,omitempty"` // 每人限领张数，0 表示不限
+	UseLimit       int32                  `protobuf:"varint,13,opt,name=use_limit,json=useLimit,proto3" json:"use_limit,omitempty"`       // 每人限用次数，0 表示不限
+	StartAt        int64                  `protobuf:"varint,14,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`          // 领取与使用的有效期 (Unix 秒)
+	EndAt          int64                  `protobuf:"varint,15,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	ValidDays      int32                  `protobuf:"varint,16,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"` // 领取后 N 天内有效 (不超过 end_at)，0 表示到 end_at 为止
+	Enabled        bool                   `protobuf:"varint,17,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponInfo) Reset() {
+	*x = CouponInfo{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponInfo) ProtoMessage() {}
+
+func (x *CouponInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponInfo.ProtoReflect.Descriptor instead.
+func (*CouponInfo) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CouponInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CouponInfo) GetScopeIds() []int64 {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CouponInfo) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetIssuedQuantity() int32 {
+	if x != nil {
+		return x.IssuedQuantity
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetClaimLimit() int32 {
+	if x != nil {
+		return x.ClaimLimit
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetUseLimit() int32 {
+	if x != nil {
+		return x.UseLimit
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SaveCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCouponResponse) Reset() {
+	*x = SaveCouponResponse{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCouponResponse) ProtoMessage() {}
+
+func (x *SaveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCouponResponse.ProtoReflect.Descriptor instead.
+func (*SaveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveCouponResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClaimableOnly bool                   `protobuf:"varint,1,opt,name=claimable_only,json=claimableOnly,proto3" json:"claimable_only,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCouponsRequest) GetClaimableOnly() bool {
+	if x != nil {
+		return x.ClaimableOnly
+	}
+	return false
+}
+
+func (x *ListCouponsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCouponsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*CouponInfo          `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsResponse) ProtoMessage() {}
+
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCouponsResponse) GetCoupons() []*CouponInfo {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *ListCouponsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ClaimCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponRequest) Reset() {
+	*x = ClaimCouponRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponRequest) ProtoMessage() {}
+
+func (x *ClaimCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimCouponRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClaimCouponRequest) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+type UserCouponInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 用户优惠券 ID，下单时使用
+	Coupon        *CouponInfo            `protobuf:"bytes,2,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"` // 0: 未使用 1: 已锁定 (下单未支付) 2: 已使用 3: 已过期
+	ClaimedAt     int64                  `protobuf:"varint,4,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	ValidUntil    int64                  `protobuf:"varint,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	OrderNo       string                 `protobuf:"bytes,6,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCouponInfo) Reset() {
+	*x = UserCouponInfo{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCouponInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponInfo) ProtoMessage() {}
+
+func (x *UserCouponInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponInfo.ProtoReflect.Descriptor instead.
+func (*UserCouponInfo) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserCouponInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetCoupon() *CouponInfo {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *UserCouponInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetClaimedAt() int64 {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+type ListUserCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // -1 表示全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsRequest) Reset() {
+	*x = ListUserCouponsRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsRequest) ProtoMessage() {}
+
+func (x *ListUserCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUserCouponsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserCouponsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ListUserCouponsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*UserCouponInfo      `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsResponse) Reset() {
+	*x = ListUserCouponsResponse{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsResponse) ProtoMessage() {}
+
+func (x *ListUserCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserCouponsResponse) GetCoupons() []*UserCouponInfo {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+type DiscountLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountLine) Reset() {
+	*x = DiscountLine{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountLine) ProtoMessage() {}
+
+func (x *DiscountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountLine.ProtoReflect.Descriptor instead.
+func (*DiscountLine) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiscountLine) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *DiscountLine) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *DiscountLine) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DiscountLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CalculateDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserCouponId  int64                  `protobuf:"varint,2,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"`
+	Lines         []*DiscountLine        `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateDiscountRequest) Reset() {
+	*x = CalculateDiscountRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountRequest) ProtoMessage() {}
+
+func (x *CalculateDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountRequest.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CalculateDiscountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CalculateDiscountRequest) GetUserCouponId() int64 {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return 0
+}
+
+func (x *CalculateDiscountRequest) GetLines() []*DiscountLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type LineDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineDiscount) Reset() {
+	*x = LineDiscount{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineDiscount) ProtoMessage() {}
+
+func (x *LineDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineDiscount.ProtoReflect.Descriptor instead.
+func (*LineDiscount) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LineDiscount) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *LineDiscount) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type DiscountResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCouponId  int64                  `protobuf:"varint,1,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"`
+	CouponId      int64                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` // 优惠总额
+	Lines         []*LineDiscount        `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`    // 按适用商品金额分摊到商品行
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountResult) Reset() {
+	*x = DiscountResult{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountResult) ProtoMessage() {}
+
+func (x *DiscountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountResult.ProtoReflect.Descriptor instead.
+func (*DiscountResult) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiscountResult) GetUserCouponId() int64 {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return 0
+}
+
+func (x *DiscountResult) GetCouponId() int64 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *DiscountResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiscountResult) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DiscountResult) GetLines() []*LineDiscount {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type LockCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserCouponId  int64                  `protobuf:"varint,2,opt,name=user_coupon_id,json=userCouponId,proto3" json:"user_coupon_id,omitempty"`
+	OrderNo       string                 `protobuf:"bytes,3,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Lines         []*DiscountLine        `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockCouponRequest) Reset() {
+	*x = LockCouponRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCouponRequest) ProtoMessage() {}
+
+func (x *LockCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCouponRequest.ProtoReflect.Descriptor instead.
+func (*LockCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LockCouponRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LockCouponRequest) GetUserCouponId() int64 {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return 0
+}
+
+func (x *LockCouponRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *LockCouponRequest) GetLines() []*DiscountLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type OrderCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCouponRequest) Reset() {
+	*x = OrderCouponRequest{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCouponRequest) ProtoMessage() {}
+
+func (x *OrderCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCouponRequest.ProtoReflect.Descriptor instead.
+func (*OrderCouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OrderCouponRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+type OrderCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      int32                  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCouponResponse) Reset() {
+	*x = OrderCouponResponse{}
+	mi := &file_proto_promotion_promotion_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCouponResponse) ProtoMessage() {}
+
+func (x *OrderCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_promotion_promotion_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCouponResponse.ProtoReflect.Descriptor instead.
+func (*OrderCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_promotion_promotion_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OrderCouponResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+var File_proto_promotion_promotion_proto protoreflect.FileDescriptor
+
+const file_proto_promotion_promotion_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/promotion/promotion.proto\x12\tpromotion\"\xe3\x03\n" +
+	"\n" +
+	"CouponInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\x06 \x01(\x03R\vmaxDiscount\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x03R\tthreshold\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\x12\x1b\n" +
+	"\tscope_ids\x18\t \x03(\x03R\bscopeIds\x12%\n" +
+	"\x0etotal_quantity\x18\n" +
+	" \x01(\x05R\rtotalQuantity\x12'\n" +
+	"\x0fissued_quantity\x18\v \x01(\x05R\x0eissuedQuantity\x12\x1f\n" +
+	"\vclaim_limit\x18\f \x01(\x05R\n" +
+	"claimLimit\x12\x1b\n" +
+	"\tuse_limit\x18\r \x01(\x05R\buseLimit\x12\x19\n" +
+	"\bstart_at\x18\x0e \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x0f \x01(\x03R\x05endAt\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x10 \x01(\x05R\tvalidDays\x12\x18\n" +
+	"\aenabled\x18\x11 \x01(\bR\aenabled\"$\n" +
+	"\x12SaveCouponResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"l\n" +
+	"\x12ListCouponsRequest\x12%\n" +
+	"\x0eclaimable_only\x18\x01 \x01(\bR\rclaimableOnly\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\\\n" +
+	"\x13ListCouponsResponse\x12/\n" +
+	"\acoupons\x18\x01 \x03(\v2\x15.promotion.CouponInfoR\acoupons\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"J\n" +
+	"\x12ClaimCouponRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\"\xc2\x01\n" +
+	"\x0eUserCouponInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12-\n" +
+	"\x06coupon\x18\x02 \x01(\v2\x15.promotion.CouponInfoR\x06coupon\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"claimed_at\x18\x04 \x01(\x03R\tclaimedAt\x12\x1f\n" +
+	"\vvalid_until\x18\x05 \x01(\x03R\n" +
+	"validUntil\x12\x19\n" +
+	"\border_no\x18\x06 \x01(\tR\aorderNo\"I\n" +
+	"\x16ListUserCouponsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"N\n" +
+	"\x17ListUserCouponsResponse\x123\n" +
+	"\acoupons\x18\x01 \x03(\v2\x19.promotion.UserCouponInfoR\acoupons\"x\n" +
+	"\fDiscountLine\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x88\x01\n" +
+	"\x18CalculateDiscountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\x0euser_coupon_id\x18\x02 \x01(\x03R\fuserCouponId\x12-\n" +
+	"\x05lines\x18\x03 \x03(\v2\x17.promotion.DiscountLineR\x05lines\"=\n" +
+	"\fLineDiscount\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xae\x01\n" +
+	"\x0eDiscountResult\x12$\n" +
+	"\x0euser_coupon_id\x18\x01 \x01(\x03R\fuserCouponId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x03R\bcouponId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12-\n" +
+	"\x05lines\x18\x05 \x03(\v2\x17.promotion.LineDiscountR\x05lines\"\x9c\x01\n" +
+	"\x11LockCouponRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\x0euser_coupon_id\x18\x02 \x01(\x03R\fuserCouponId\x12\x19\n" +
+	"\border_no\x18\x03 \x01(\tR\aorderNo\x12-\n" +
+	"\x05lines\x18\x04 \x03(\v2\x17.promotion.DiscountLineR\x05lines\"/\n" +
+	"\x12OrderCouponRequest\x12\x19\n" +
+	"\border_no\x18\x01 \x01(\tR\aorderNo\"1\n" +
+	"\x13OrderCouponResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x05R\baffected2\x82\x05\n" +
+	"\x10PromotionService\x12B\n" +
+	"\n" +
+	"SaveCoupon\x12\x15.promotion.CouponInfo\x1a\x1d.promotion.SaveCouponResponse\x12L\n" +
+	"\vListCoupons\x12\x1d.promotion.ListCouponsRequest\x1a\x1e.promotion.ListCouponsResponse\x12G\n" +
+	"\vClaimCoupon\x12\x1d.promotion.ClaimCouponRequest\x1a\x19.promotion.UserCouponInfo\x12X\n" +
+	"\x0fListUserCoupons\x12!.promotion.ListUserCouponsRequest\x1a\".promotion.ListUserCouponsResponse\x12S\n" +
+	"\x11CalculateDiscount\x12#.promotion.CalculateDiscountRequest\x1a\x19.promotion.DiscountResult\x12E\n" +
+	"\n" +
+	"LockCoupon\x12\x1c.promotion.LockCouponRequest\x1a\x19.promotion.DiscountResult\x12N\n" +
+	"\rReleaseCoupon\x12\x1d.promotion.OrderCouponRequest\x1a\x1e.promotion.OrderCouponResponse\x12M\n" +
+	"\fRedeemCoupon\x12\x1d.promotion.OrderCouponRequest\x1a\x1e.promotion.OrderCouponResponseB\x1eZ\x1cgo-ecommerce/proto/promotionb\x06proto3"
+
+var (
+	file_proto_promotion_promotion_proto_rawDescOnce sync.Once
+	file_proto_promotion_promotion_proto_rawDescData []byte
+)
+
+func file_proto_promotion_promotion_proto_rawDescGZIP() []byte {
+	file_proto_promotion_promotion_proto_rawDescOnce.Do(func() {
+		file_proto_promotion_promotion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_promotion_promotion_proto_rawDesc), len(file_proto_promotion_promotion_proto_rawDesc)))
+	})
+	return file_proto_promotion_promotion_proto_rawDescData
+}
+
+var file_proto_promotion_promotion_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_promotion_promotion_proto_goTypes = []any{
+	(*CouponInfo)(nil),               // 0: promotion.CouponInfo
+	(*SaveCouponResponse)(nil),       // 1: promotion.SaveCouponResponse
+	(*ListCouponsRequest)(nil),       // 2: promotion.ListCouponsRequest
+	(*ListCouponsResponse)(nil),      // 3: promotion.ListCouponsResponse
+	(*ClaimCouponRequest)(nil),       // 4: promotion.ClaimCouponRequest
+	(*UserCouponInfo)(nil),           // 5: promotion.UserCouponInfo
+	(*ListUserCouponsRequest)(nil),   // 6: promotion.ListUserCouponsRequest
+	(*ListUserCouponsResponse)(nil),  // 7: promotion.ListUserCouponsResponse
+	(*DiscountLine)(nil),             // 8: promotion.DiscountLine
+	(*CalculateDiscountRequest)(nil), // 9: promotion.CalculateDiscountRequest
+	(*LineDiscount)(nil),             // 10: promotion.LineDiscount
+	(*DiscountResult)(nil),           // 11: promotion.DiscountResult
+	(*LockCouponRequest)(nil),        // 12: promotion.LockCouponRequest
+	(*OrderCouponRequest)(nil),       // 13: promotion.OrderCouponRequest
+	(*OrderCouponResponse)(nil),      // 14: promotion.OrderCouponResponse
+}
+var file_proto_promotion_promotion_proto_depIdxs = []int32{
+	0,  // 0: promotion.ListCouponsResponse.coupons:type_name -> promotion.CouponInfo
+	0,  // 1: promotion.UserCouponInfo.coupon:type_name -> promotion.CouponInfo
+	5,  // 2: promotion.ListUserCouponsResponse.coupons:type_name -> promotion.UserCouponInfo
+	8,  // 3: promotion.CalculateDiscountRequest.lines:type_name -> promotion.DiscountLine
+	10, // 4: promotion.DiscountResult.lines:type_name -> promotion.LineDiscount
+	8,  // 5: promotion.LockCouponRequest.lines:type_name -> promotion.DiscountLine
+	0,  // 6: promotion.PromotionService.SaveCoupon:input_type -> promotion.CouponInfo
+	2,  // 7: promotion.PromotionService.ListCoupons:input_type -> promotion.ListCouponsRequest
+	4,  // 8: promotion.PromotionService.ClaimCoupon:input_type -> promotion.ClaimCouponRequest
+	6,  // 9: promotion.PromotionService.ListUserCoupons:input_type -> promotion.ListUserCouponsRequest
+	9,  // 10: promotion.PromotionService.CalculateDiscount:input_type -> promotion.CalculateDiscountRequest
+	12, // 11: promotion.PromotionService.LockCoupon:input_type -> promotion.LockCouponRequest
+	13, // 12: promotion.PromotionService.ReleaseCoupon:input_type -> promotion.OrderCouponRequest
+	13, // 13: promotion.PromotionService.RedeemCoupon:input_type -> promotion.OrderCouponRequest
+	1,  // 14: promotion.PromotionService.SaveCoupon:output_type -> promotion.SaveCouponResponse
+	3,  // 15: promotion.PromotionService.ListCoupons:output_type -> promotion.ListCouponsResponse
+	5,  // 16: promotion.PromotionService.ClaimCoupon:output_type -> promotion.UserCouponInfo
+	7,  // 17: promotion.PromotionService.ListUserCoupons:output_type -> promotion.ListUserCouponsResponse
+	11, // 18: promotion.PromotionService.CalculateDiscount:output_type -> promotion.DiscountResult
+	11, // 19: promotion.PromotionService.LockCoupon:output_type -> promotion.DiscountResult
+	14, // 20: promotion.PromotionService.ReleaseCoupon:output_type -> promotion.OrderCouponResponse
+	14, // 21: promotion.PromotionService.RedeemCoupon:output_type -> promotion.OrderCouponResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_promotion_promotion_proto_init() }
+func file_proto_promotion_promotion_proto_init() {
+	if File_proto_promotion_promotion_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_promotion_promotion_proto_rawDesc), len(file_proto_promotion_promotion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_promotion_promotion_proto_goTypes,
+		DependencyIndexes: file_proto_promotion_promotion_proto_depIdxs,
+		MessageInfos:      file_proto_promotion_promotion_proto_msgTypes,
+	}.Build()
+	File_proto_promotion_promotion_proto = out.File
+	file_proto_promotion_promotion_proto_goTypes = nil
+	file_proto_promotion_promotion_proto_depIdxs = nil
+}
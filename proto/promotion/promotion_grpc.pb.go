@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v6.33.4
+// source: proto/promotion/promotion.proto
+
+package promotion
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PromotionService_SaveCoupon_FullMethodName        = "/promotion.PromotionService/SaveCoupon"
+	PromotionService_ListCoupons_FullMethodName       = "/promotion.PromotionService/ListCoupons"
+	PromotionService_ClaimCoupon_FullMethodName       = "/promotion.PromotionService/ClaimCoupon"
+	PromotionService_ListUserCoupons_FullMethodName   = "/promotion.PromotionService/ListUserCoupons"
+	PromotionService_CalculateDiscount_FullMethodName = "/promotion.PromotionService/CalculateDiscount"
+	PromotionService_LockCoupon_FullMethodName        = "/promotion.PromotionService/LockCoupon"
+	PromotionService_ReleaseCoupon_FullMethodName     = "/promotion.PromotionService/ReleaseCoupon"
+	PromotionService_RedeemCoupon_FullMethodName      = "/promotion.PromotionService/RedeemCoupon"
+)
+
+// PromotionServiceClient is the client API for PromotionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromotionServiceClient interface {
+	// 后台：创建 / 修改优惠券模板 (id 为 0 时创建)
+	SaveCoupon(ctx context.Context, in *CouponInfo, opts ...grpc.CallOption) (*SaveCouponResponse, error)
+	// 优惠券模板列表 (claimable_only 为 true 时只返回当前可领取的)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	// 用户领取优惠券
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponInfo, error)
+	// 用户的优惠券列表
+	ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error)
+	// 试算优惠 (订单预览)，不占用优惠券
+	CalculateDiscount(ctx context.Context, in *CalculateDiscountRequest, opts ...grpc.CallOption) (*DiscountResult, error)
+	// 下单时锁定优惠券，同一订单重复调用返回相同结果
+	LockCoupon(ctx context.Context, in *LockCouponRequest, opts ...grpc.CallOption) (*DiscountResult, error)
+	// 订单取消或下单失败时释放锁定的优惠券
+	ReleaseCoupon(ctx context.Context, in *OrderCouponRequest, opts ...grpc.CallOption) (*OrderCouponResponse, error)
+	// 订单支付后核销优惠券
+	RedeemCoupon(ctx context.Context, in *OrderCouponRequest, opts ...grpc.CallOption) (*OrderCouponResponse, error)
+}
+
+type promotionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromotionServiceClient(cc grpc.ClientConnInterface) PromotionServiceClient {
+	return &promotionServiceClient{cc}
+}
+
+func (c *promotionServiceClient) SaveCoupon(ctx context.Context, in *CouponInfo, opts ...grpc.CallOption) (*SaveCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCouponResponse)
+	err := c.cc.Invoke(ctx, PromotionService_SaveCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, PromotionService_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponInfo)
+	err := c.cc.Invoke(ctx, PromotionService_ClaimCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCouponsResponse)
+	err := c.cc.Invoke(ctx, PromotionService_ListUserCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) CalculateDiscount(ctx context.Context, in *CalculateDiscountRequest, opts ...grpc.CallOption) (*DiscountResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountResult)
+	err := c.cc.Invoke(ctx, PromotionService_CalculateDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) LockCoupon(ctx context.Context, in *LockCouponRequest, opts ...grpc.CallOption) (*DiscountResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountResult)
+	err := c.cc.Invoke(ctx, PromotionService_LockCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) ReleaseCoupon(ctx context.Context, in *OrderCouponRequest, opts ...grpc.CallOption) (*OrderCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderCouponResponse)
+	err := c.cc.Invoke(ctx, PromotionService_ReleaseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) RedeemCoupon(ctx context.Context, in *OrderCouponRequest, opts ...grpc.CallOption) (*OrderCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderCouponResponse)
+	err := c.cc.Invoke(ctx, PromotionService_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromotionServiceServer is the server API for PromotionService service.
+// All implementations must embed UnimplementedPromotionServiceServer
+// for forward compatibility.
+type PromotionServiceServer interface {
+	// 后台：创建 / 修改优惠券模板 (id 为 0 时创建)
+	SaveCoupon(context.Context, *CouponInfo) (*SaveCouponResponse, error)
+	// 优惠券模板列表 (claimable_only 为 true 时只返回当前可领取的)
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	// 用户领取优惠券
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponInfo, error)
+	// 用户的优惠券列表
+	ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsResponse, error)
+	// 试算优惠 (订单预览)，不占用优惠券
+	CalculateDiscount(context.Context, *CalculateDiscountRequest) (*DiscountResult, error)
+	// 下单时锁定优惠券，同一订单重复调用返回相同结果
+	LockCoupon(context.Context, *LockCouponRequest) (*DiscountResult, error)
+	// 订单取消或下单失败时释放锁定的优惠券
+	ReleaseCoupon(context.Context, *OrderCouponRequest) (*OrderCouponResponse, error)
+	// 订单支付后核销优惠券
+	RedeemCoupon(context.Context, *OrderCouponRequest) (*OrderCouponResponse, error)
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+// UnimplementedPromotionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromotionServiceServer struct{}
+
+func (UnimplementedPromotionServiceServer) SaveCoupon(context.Context, *CouponInfo) (*SaveCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveCoupon not implemented")
+}
+func (UnimplementedPromotionServiceServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedPromotionServiceServer) ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (UnimplementedPromotionServiceServer) ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserCoupons not implemented")
+}
+func (UnimplementedPromotionServiceServer) CalculateDiscount(context.Context, *CalculateDiscountRequest) (*DiscountResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateDiscount not implemented")
+}
+func (UnimplementedPromotionServiceServer) LockCoupon(context.Context, *LockCouponRequest) (*DiscountResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method LockCoupon not implemented")
+}
+func (UnimplementedPromotionServiceServer) ReleaseCoupon(context.Context, *OrderCouponRequest) (*OrderCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseCoupon not implemented")
+}
+func (UnimplementedPromotionServiceServer) RedeemCoupon(context.Context, *OrderCouponRequest) (*OrderCouponResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedPromotionServiceServer) mustEmbedUnimplementedPromotionServiceServer() {}
+func (UnimplementedPromotionServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePromotionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromotionServiceServer will
+// result in compilation errors.
+type UnsafePromotionServiceServer interface {
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+func RegisterPromotionServiceServer(s grpc.ServiceRegistrar, srv PromotionServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromotionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromotionService_ServiceDesc, srv)
+}
+
+func _PromotionService_SaveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).SaveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_SaveCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).SaveCoupon(ctx, req.(*CouponInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ClaimCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_ListUserCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ListUserCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ListUserCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ListUserCoupons(ctx, req.(*ListUserCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_CalculateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).CalculateDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_CalculateDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).CalculateDiscount(ctx, req.(*CalculateDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_LockCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).LockCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_LockCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).LockCoupon(ctx, req.(*LockCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_ReleaseCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ReleaseCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ReleaseCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ReleaseCoupon(ctx, req.(*OrderCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).RedeemCoupon(ctx, req.(*OrderCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromotionService_ServiceDesc is the grpc.ServiceDesc for PromotionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromotionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "promotion.PromotionService",
+	HandlerType: (*PromotionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveCoupon",
+			Handler:    _PromotionService_SaveCoupon_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _PromotionService_ListCoupons_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _PromotionService_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "ListUserCoupons",
+			Handler:    _PromotionService_ListUserCoupons_Handler,
+		},
+		{
+			MethodName: "CalculateDiscount",
+			Handler:    _PromotionService_CalculateDiscount_Handler,
+		},
+		{
+			MethodName: "LockCoupon",
+			Handler:    _PromotionService_LockCoupon_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupon",
+			Handler:    _PromotionService_ReleaseCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _PromotionService_RedeemCoupon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/promotion/promotion.proto",
+}
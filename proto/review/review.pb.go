@@ -32,6 +32,8 @@ type ReviewInfo struct {
 	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	SkuName       string                 `protobuf:"bytes,9,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"` // 购买时的规格名
+	OrderNo       string                 `protobuf:"bytes,10,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	ProductId     int64                  `protobuf:"varint,11,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,6 +131,20 @@ func (x *ReviewInfo) GetSkuName() string {
 	return ""
 }
 
+func (x *ReviewInfo) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
 type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -521,11 +537,327 @@ func (x *CheckReviewStatusResponse) GetReviewId() int64 {
 	return 0
 }
 
+type ListUserReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserReviewsRequest) Reset() {
+	*x = ListUserReviewsRequest{}
+	mi := &file_proto_review_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReviewsRequest) ProtoMessage() {}
+
+func (x *ListUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserReviewsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListUserReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*ReviewInfo          `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserReviewsResponse) Reset() {
+	*x = ListUserReviewsResponse{}
+	mi := &file_proto_review_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReviewsResponse) ProtoMessage() {}
+
+func (x *ListUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUserReviewsResponse) GetReviews() []*ReviewInfo {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type AnonymizeUserReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserReviewsRequest) Reset() {
+	*x = AnonymizeUserReviewsRequest{}
+	mi := &file_proto_review_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserReviewsRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AnonymizeUserReviewsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AnonymizeUserReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anonymized    int64                  `protobuf:"varint,1,opt,name=anonymized,proto3" json:"anonymized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserReviewsResponse) Reset() {
+	*x = AnonymizeUserReviewsResponse{}
+	mi := &file_proto_review_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserReviewsResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AnonymizeUserReviewsResponse) GetAnonymized() int64 {
+	if x != nil {
+		return x.Anonymized
+	}
+	return 0
+}
+
+type ListProductRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductRatingsRequest) Reset() {
+	*x = ListProductRatingsRequest{}
+	mi := &file_proto_review_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductRatingsRequest) ProtoMessage() {}
+
+func (x *ListProductRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductRatingsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{11}
+}
+
+type ProductRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StarSum       int64                  `protobuf:"varint,2,opt,name=star_sum,json=starSum,proto3" json:"star_sum,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRating) Reset() {
+	*x = ProductRating{}
+	mi := &file_proto_review_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRating) ProtoMessage() {}
+
+func (x *ProductRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRating.ProtoReflect.Descriptor instead.
+func (*ProductRating) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProductRating) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductRating) GetStarSum() int64 {
+	if x != nil {
+		return x.StarSum
+	}
+	return 0
+}
+
+func (x *ProductRating) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListProductRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*ProductRating       `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductRatingsResponse) Reset() {
+	*x = ListProductRatingsResponse{}
+	mi := &file_proto_review_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductRatingsResponse) ProtoMessage() {}
+
+func (x *ListProductRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductRatingsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_review_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListProductRatingsResponse) GetRatings() []*ProductRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
 var File_proto_review_review_proto protoreflect.FileDescriptor
 
 const file_proto_review_review_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/review/review.proto\x12\x06review\"\xfb\x01\n" +
+	"\x19proto/review/review.proto\x12\x06review\"\xb5\x02\n" +
 	"\n" +
 	"ReviewInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
@@ -538,7 +870,11 @@ const file_proto_review_review_proto_rawDesc = "" +
 	"\x06images\x18\a \x03(\tR\x06images\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x19\n" +
-	"\bsku_name\x18\t \x01(\tR\askuName\"\xa6\x02\n" +
+	"\bsku_name\x18\t \x01(\tR\askuName\x12\x19\n" +
+	"\border_no\x18\n" +
+	" \x01(\tR\aorderNo\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\v \x01(\x03R\tproductId\"\xa6\x02\n" +
 	"\x13CreateReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x15\n" +
@@ -570,11 +906,32 @@ const file_proto_review_review_proto_rawDesc = "" +
 	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\"[\n" +
 	"\x19CheckReviewStatusResponse\x12!\n" +
 	"\fhas_reviewed\x18\x01 \x01(\bR\vhasReviewed\x12\x1b\n" +
-	"\treview_id\x18\x02 \x01(\x03R\breviewId2\xfc\x01\n" +
+	"\treview_id\x18\x02 \x01(\x03R\breviewId\"1\n" +
+	"\x16ListUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
+	"\x17ListUserReviewsResponse\x12,\n" +
+	"\areviews\x18\x01 \x03(\v2\x12.review.ReviewInfoR\areviews\"6\n" +
+	"\x1bAnonymizeUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
+	"\x1cAnonymizeUserReviewsResponse\x12\x1e\n" +
+	"\n" +
+	"anonymized\x18\x01 \x01(\x03R\n" +
+	"anonymized\"\x1b\n" +
+	"\x19ListProductRatingsRequest\"_\n" +
+	"\rProductRating\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x19\n" +
+	"\bstar_sum\x18\x02 \x01(\x03R\astarSum\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"M\n" +
+	"\x1aListProductRatingsResponse\x12/\n" +
+	"\aratings\x18\x01 \x03(\v2\x15.review.ProductRatingR\aratings2\x90\x04\n" +
 	"\rReviewService\x12I\n" +
 	"\fCreateReview\x12\x1b.review.CreateReviewRequest\x1a\x1c.review.CreateReviewResponse\x12F\n" +
 	"\vListReviews\x12\x1a.review.ListReviewsRequest\x1a\x1b.review.ListReviewsResponse\x12X\n" +
-	"\x11CheckReviewStatus\x12 .review.CheckReviewStatusRequest\x1a!.review.CheckReviewStatusResponseB\x1bZ\x19go-ecommerce/proto/reviewb\x06proto3"
+	"\x11CheckReviewStatus\x12 .review.CheckReviewStatusRequest\x1a!.review.CheckReviewStatusResponse\x12R\n" +
+	"\x0fListUserReviews\x12\x1e.review.ListUserReviewsRequest\x1a\x1f.review.ListUserReviewsResponse\x12a\n" +
+	"\x14AnonymizeUserReviews\x12#.review.AnonymizeUserReviewsRequest\x1a$.review.AnonymizeUserReviewsResponse\x12[\n" +
+	"\x12ListProductRatings\x12!.review.ListProductRatingsRequest\x1a\".review.ListProductRatingsResponseB\x1bZ\x19go-ecommerce/proto/reviewb\x06proto3"
 
 var (
 	file_proto_review_review_proto_rawDescOnce sync.Once
@@ -588,29 +945,44 @@ func file_proto_review_review_proto_rawDescGZIP() []byte {
 	return file_proto_review_review_proto_rawDescData
 }
 
-var file_proto_review_review_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_review_review_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_review_review_proto_goTypes = []any{
-	(*ReviewInfo)(nil),                // 0: review.ReviewInfo
-	(*CreateReviewRequest)(nil),       // 1: review.CreateReviewRequest
-	(*CreateReviewResponse)(nil),      // 2: review.CreateReviewResponse
-	(*ListReviewsRequest)(nil),        // 3: review.ListReviewsRequest
-	(*ListReviewsResponse)(nil),       // 4: review.ListReviewsResponse
-	(*CheckReviewStatusRequest)(nil),  // 5: review.CheckReviewStatusRequest
-	(*CheckReviewStatusResponse)(nil), // 6: review.CheckReviewStatusResponse
+	(*ReviewInfo)(nil),                   // 0: review.ReviewInfo
+	(*CreateReviewRequest)(nil),          // 1: review.CreateReviewRequest
+	(*CreateReviewResponse)(nil),         // 2: review.CreateReviewResponse
+	(*ListReviewsRequest)(nil),           // 3: review.ListReviewsRequest
+	(*ListReviewsResponse)(nil),          // 4: review.ListReviewsResponse
+	(*CheckReviewStatusRequest)(nil),     // 5: review.CheckReviewStatusRequest
+	(*CheckReviewStatusResponse)(nil),    // 6: review.CheckReviewStatusResponse
+	(*ListUserReviewsRequest)(nil),       // 7: review.ListUserReviewsRequest
+	(*ListUserReviewsResponse)(nil),      // 8: review.ListUserReviewsResponse
+	(*AnonymizeUserReviewsRequest)(nil),  // 9: review.AnonymizeUserReviewsRequest
+	(*AnonymizeUserReviewsResponse)(nil), // 10: review.AnonymizeUserReviewsResponse
+	(*ListProductRatingsRequest)(nil),    // 11: review.ListProductRatingsRequest
+	(*ProductRating)(nil),                // 12: review.ProductRating
+	(*ListProductRatingsResponse)(nil),   // 13: review.ListProductRatingsResponse
 }
 var file_proto_review_review_proto_depIdxs = []int32{
-	0, // 0: review.ListReviewsResponse.reviews:type_name -> review.ReviewInfo
-	1, // 1: review.ReviewService.CreateReview:input_type -> review.CreateReviewRequest
-	3, // 2: review.ReviewService.ListReviews:input_type -> review.ListReviewsRequest
-	5, // 3: review.ReviewService.CheckReviewStatus:input_type -> review.CheckReviewStatusRequest
-	2, // 4: review.ReviewService.CreateReview:output_type -> review.CreateReviewResponse
-	4, // 5: review.ReviewService.ListReviews:output_type -> review.ListReviewsResponse
-	6, // 6: review.ReviewService.CheckReviewStatus:output_type -> review.CheckReviewStatusResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: review.ListReviewsResponse.reviews:type_name -> review.ReviewInfo
+	0,  // 1: review.ListUserReviewsResponse.reviews:type_name -> review.ReviewInfo
+	12, // 2: review.ListProductRatingsResponse.ratings:type_name -> review.ProductRating
+	1,  // 3: review.ReviewService.CreateReview:input_type -> review.CreateReviewRequest
+	3,  // 4: review.ReviewService.ListReviews:input_type -> review.ListReviewsRequest
+	5,  // 5: review.ReviewService.CheckReviewStatus:input_type -> review.CheckReviewStatusRequest
+	7,  // 6: review.ReviewService.ListUserReviews:input_type -> review.ListUserReviewsRequest
+	9,  // 7: review.ReviewService.AnonymizeUserReviews:input_type -> review.AnonymizeUserReviewsRequest
+	11, // 8: review.ReviewService.ListProductRatings:input_type -> review.ListProductRatingsRequest
+	2,  // 9: review.ReviewService.CreateReview:output_type -> review.CreateReviewResponse
+	4,  // 10: review.ReviewService.ListReviews:output_type -> review.ListReviewsResponse
+	6,  // 11: review.ReviewService.CheckReviewStatus:output_type -> review.CheckReviewStatusResponse
+	8,  // 12: review.ReviewService.ListUserReviews:output_type -> review.ListUserReviewsResponse
+	10, // 13: review.ReviewService.AnonymizeUserReviews:output_type -> review.AnonymizeUserReviewsResponse
+	13, // 14: review.ReviewService.ListProductRatings:output_type -> review.ListProductRatingsResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_review_review_proto_init() }
@@ -624,7 +996,7 @@ func file_proto_review_review_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_review_review_proto_rawDesc), len(file_proto_review_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
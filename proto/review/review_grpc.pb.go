@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReviewService_CreateReview_FullMethodName      = "/review.ReviewService/CreateReview"
-	ReviewService_ListReviews_FullMethodName       = "/review.ReviewService/ListReviews"
-	ReviewService_CheckReviewStatus_FullMethodName = "/review.ReviewService/CheckReviewStatus"
+	ReviewService_CreateReview_FullMethodName         = "/review.ReviewService/CreateReview"
+	ReviewService_ListReviews_FullMethodName          = "/review.ReviewService/ListReviews"
+	ReviewService_CheckReviewStatus_FullMethodName    = "/review.ReviewService/CheckReviewStatus"
+	ReviewService_ListUserReviews_FullMethodName      = "/review.ReviewService/ListUserReviews"
+	ReviewService_AnonymizeUserReviews_FullMethodName = "/review.ReviewService/AnonymizeUserReviews"
+	ReviewService_ListProductRatings_FullMethodName   = "/review.ReviewService/ListProductRatings"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -34,6 +37,12 @@ type ReviewServiceClient interface {
 	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
 	// 获取商品是否有当前用户的评价 (用于前端判断显示"已评价")
 	CheckReviewStatus(ctx context.Context, in *CheckReviewStatusRequest, opts ...grpc.CallOption) (*CheckReviewStatusResponse, error)
+	// 获取用户发表的全部评价 (个人数据导出)
+	ListUserReviews(ctx context.Context, in *ListUserReviewsRequest, opts ...grpc.CallOption) (*ListUserReviewsResponse, error)
+	// 注销账号：评价改为匿名展示
+	AnonymizeUserReviews(ctx context.Context, in *AnonymizeUserReviewsRequest, opts ...grpc.CallOption) (*AnonymizeUserReviewsResponse, error)
+	// 按商品汇总评价星级 (商品服务回填评分)
+	ListProductRatings(ctx context.Context, in *ListProductRatingsRequest, opts ...grpc.CallOption) (*ListProductRatingsResponse, error)
 }
 
 type reviewServiceClient struct {
@@ -74,6 +83,36 @@ func (c *reviewServiceClient) CheckReviewStatus(ctx context.Context, in *CheckRe
 	return out, nil
 }
 
+func (c *reviewServiceClient) ListUserReviews(ctx context.Context, in *ListUserReviewsRequest, opts ...grpc.CallOption) (*ListUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ListUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) AnonymizeUserReviews(ctx context.Context, in *AnonymizeUserReviewsRequest, opts ...grpc.CallOption) (*AnonymizeUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_AnonymizeUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ListProductRatings(ctx context.Context, in *ListProductRatingsRequest, opts ...grpc.CallOption) (*ListProductRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductRatingsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ListProductRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServiceServer is the server API for ReviewService service.
 // All implementations must embed UnimplementedReviewServiceServer
 // for forward compatibility.
@@ -84,6 +123,12 @@ type ReviewServiceServer interface {
 	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
 	// 获取商品是否有当前用户的评价 (用于前端判断显示"已评价")
 	CheckReviewStatus(context.Context, *CheckReviewStatusRequest) (*CheckReviewStatusResponse, error)
+	// 获取用户发表的全部评价 (个人数据导出)
+	ListUserReviews(context.Context, *ListUserReviewsRequest) (*ListUserReviewsResponse, error)
+	// 注销账号：评价改为匿名展示
+	AnonymizeUserReviews(context.Context, *AnonymizeUserReviewsRequest) (*AnonymizeUserReviewsResponse, error)
+	// 按商品汇总评价星级 (商品服务回填评分)
+	ListProductRatings(context.Context, *ListProductRatingsRequest) (*ListProductRatingsResponse, error)
 	mustEmbedUnimplementedReviewServiceServer()
 }
 
@@ -103,6 +148,15 @@ func (UnimplementedReviewServiceServer) ListReviews(context.Context, *ListReview
 func (UnimplementedReviewServiceServer) CheckReviewStatus(context.Context, *CheckReviewStatusRequest) (*CheckReviewStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckReviewStatus not implemented")
 }
+func (UnimplementedReviewServiceServer) ListUserReviews(context.Context, *ListUserReviewsRequest) (*ListUserReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) AnonymizeUserReviews(context.Context, *AnonymizeUserReviewsRequest) (*AnonymizeUserReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnonymizeUserReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) ListProductRatings(context.Context, *ListProductRatingsRequest) (*ListProductRatingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductRatings not implemented")
+}
 func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
 func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
@@ -178,6 +232,60 @@ func _ReviewService_CheckReviewStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_ListUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ListUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListUserReviews(ctx, req.(*ListUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_AnonymizeUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).AnonymizeUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_AnonymizeUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).AnonymizeUserReviews(ctx, req.(*AnonymizeUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ListProductRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListProductRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ListProductRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListProductRatings(ctx, req.(*ListProductRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +305,18 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckReviewStatus",
 			Handler:    _ReviewService_CheckReviewStatus_Handler,
 		},
+		{
+			MethodName: "ListUserReviews",
+			Handler:    _ReviewService_ListUserReviews_Handler,
+		},
+		{
+			MethodName: "AnonymizeUserReviews",
+			Handler:    _ReviewService_AnonymizeUserReviews_Handler,
+		},
+		{
+			MethodName: "ListProductRatings",
+			Handler:    _ReviewService_ListProductRatings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/review/review.proto",